@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+)
+
+// baselineCheck runs the test suite of the module without mutations, if
+// required. When the baseline fails, the mutants would be killed by the
+// tests that already fail, so the results would be meaningless.
+func baselineCheck(cmdContext execContext, mod gomodule.GoModule) error {
+	if !configuration.Get[bool](configuration.UnleashGreenBaselineKey) {
+		return nil
+	}
+	args := []string{"test"}
+	if tags := configuration.Get[string](configuration.UnleashTagsKey); tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	args = append(args, "./...")
+	cmd := cmdContext("go", args...)
+	cmd.Dir = mod.Root
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("the tests fail without mutations, fix them before the mutation testing: %w\n%s", err, out)
+	}
+
+	return nil
+}
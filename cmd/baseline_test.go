@@ -0,0 +1,63 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+)
+
+func TestBaselineCheck(t *testing.T) {
+	mod := gomodule.GoModule{Name: "example.com", Root: "."}
+	testCases := []struct {
+		name    string
+		cmd     execContext
+		require bool
+		wantErr bool
+	}{
+		{
+			name:    "it proceeds if the baseline passes",
+			cmd:     fakeExecCommand("TestBuildProcessSuccess"),
+			require: true,
+		},
+		{
+			name:    "it aborts if the baseline fails",
+			cmd:     fakeExecCommand("TestBuildProcessFailure"),
+			require: true,
+			wantErr: true,
+		},
+		{
+			name: "it doesn't run the baseline if not required",
+			cmd:  fakeExecCommand("TestBuildProcessFailure"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashGreenBaselineKey, tc.require)
+			defer configuration.Reset()
+
+			err := baselineCheck(tc.cmd, mod)
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("baselineCheck() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
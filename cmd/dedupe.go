@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// dedupePriority returns the order in which the mutator.Type are kept when
+// many of them apply to the same position, or nil if dedupe-per-position
+// is not set. The types of the dedupe-priority come first, in the given
+// order, followed by the others in their usual order.
+func dedupePriority() ([]mutator.Type, error) {
+	if !configuration.Get[bool](configuration.UnleashDedupePerPositionKey) {
+		return nil, nil
+	}
+
+	priority := make([]mutator.Type, 0, len(mutator.Types))
+	for _, name := range viper.GetStringSlice(configuration.UnleashDedupePriorityKey) {
+		mt, ok := mutantTypeByParam(name)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s: %w: %q", paramDedupePriority, ErrUnknownMutantType, name)
+		}
+		if !slices.Contains(priority, mt) {
+			priority = append(priority, mt)
+		}
+	}
+	for _, mt := range mutator.Types {
+		if !slices.Contains(priority, mt) {
+			priority = append(priority, mt)
+		}
+	}
+
+	return priority, nil
+}
+
+// mutantTypeByParam returns the mutator.Type with the given name, as it is
+// used in the flags or in the reports.
+func mutantTypeByParam(name string) (mutator.Type, bool) {
+	name = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", "-"))
+	for _, mt := range mutator.Types {
+		if mutantTypeParam(mt) == name {
+			return mt, true
+		}
+	}
+
+	return 0, false
+}
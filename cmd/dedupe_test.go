@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestDedupePriority(t *testing.T) {
+	t.Run("it is nil if dedupe-per-position is not set", func(t *testing.T) {
+		configuration.Set(configuration.UnleashDedupePriorityKey, []string{"conditionals-negation"})
+		defer configuration.Reset()
+
+		got, err := dedupePriority()
+		if err != nil || got != nil {
+			t.Errorf("expected no priority, got %v, %v", got, err)
+		}
+	})
+
+	t.Run("it puts the given types first", func(t *testing.T) {
+		configuration.Set(configuration.UnleashDedupePerPositionKey, true)
+		configuration.Set(configuration.UnleashDedupePriorityKey, []string{"conditionals-negation", "ARITHMETIC_BASE"})
+		defer configuration.Reset()
+
+		got, err := dedupePriority()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(mutator.Types) {
+			t.Fatalf("expected all the %d types, got %d", len(mutator.Types), len(got))
+		}
+		want := []mutator.Type{mutator.ConditionalsNegation, mutator.ArithmeticBase, mutator.ConditionalsBoundary}
+		for i, mt := range want {
+			if got[i] != mt {
+				t.Errorf("expected %s at %d, got %s", mt, i, got[i])
+			}
+		}
+	})
+
+	t.Run("it fails on an unknown type", func(t *testing.T) {
+		configuration.Set(configuration.UnleashDedupePerPositionKey, true)
+		configuration.Set(configuration.UnleashDedupePriorityKey, []string{"not-a-type"})
+		defer configuration.Reset()
+
+		if _, err := dedupePriority(); !errors.Is(err, ErrUnknownMutantType) {
+			t.Errorf("expected %v, got %v", ErrUnknownMutantType, err)
+		}
+	})
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+type explainCmd struct {
+	cmd *cobra.Command
+}
+
+// ErrUnknownMutantType is returned by explain when the mutant type is not
+// a known one.
+var ErrUnknownMutantType = errors.New("unknown mutant type")
+
+// explanation describes a mutator.Type with an example of its mutation.
+type explanation struct {
+	description string
+	original    string
+	mutated     string
+}
+
+// explanations is the registry of the descriptions of the built-in
+// mutator.Type, it must be kept up to date when adding new mutant types.
+var explanations = map[mutator.Type]explanation{
+	mutator.ArithmeticBase: {
+		description: "Replaces an arithmetic operator with its opposite.",
+		original:    "a := b + c",
+		mutated:     "a := b - c",
+	},
+	mutator.ConditionalsBoundary: {
+		description: "Includes or excludes the boundary of a relational operator.",
+		original:    "if a > b {",
+		mutated:     "if a >= b {",
+	},
+	mutator.ConditionalsNegation: {
+		description: "Negates a relational operator.",
+		original:    "if a == b {",
+		mutated:     "if a != b {",
+	},
+	mutator.IncrementDecrement: {
+		description: "Replaces an increment with a decrement and vice versa.",
+		original:    "i++",
+		mutated:     "i--",
+	},
+	mutator.InvertAssignments: {
+		description: "Replaces an arithmetic assignment with its opposite.",
+		original:    "a += b",
+		mutated:     "a -= b",
+	},
+	mutator.InvertBitwise: {
+		description: "Replaces a bitwise operator with its opposite.",
+		original:    "a := b & c",
+		mutated:     "a := b | c",
+	},
+	mutator.InvertBitwiseAssignments: {
+		description: "Replaces a bitwise assignment with its opposite.",
+		original:    "a &= b",
+		mutated:     "a |= b",
+	},
+	mutator.InvertLogical: {
+		description: "Replaces a logical operator with its opposite.",
+		original:    "if a && b {",
+		mutated:     "if a || b {",
+	},
+	mutator.InvertLoopCtrl: {
+		description: "Replaces a loop control statement with its opposite.",
+		original:    "continue",
+		mutated:     "break",
+	},
+	mutator.InvertNegatives: {
+		description: "Removes the negation of a number.",
+		original:    "a := -b",
+		mutated:     "a := +b",
+	},
+	mutator.RemoveSelfAssignments: {
+		description: "Replaces a self-assignment with a plain assignment.",
+		original:    "a += b",
+		mutated:     "a = b",
+	},
+	mutator.DropAppendArg: {
+		description: "Removes the last value appended by a call to append.",
+		original:    "s = append(s, a, b)",
+		mutated:     "s = append(s, a)",
+	},
+	mutator.InvertErrorCheck: {
+		description: "Negates the result of an errors.Is or errors.As check.",
+		original:    "if errors.Is(err, io.EOF) {",
+		mutated:     "if !errors.Is(err, io.EOF) {",
+	},
+	mutator.NilCheckInvert: {
+		description: "Inverts a comparison with nil, except for the errors named err.",
+		original:    "if m == nil {",
+		mutated:     "if m != nil {",
+	},
+	mutator.MinMaxSwap: {
+		description: "Swaps the min and max builtins.",
+		original:    "n := min(a, b)",
+		mutated:     "n := max(a, b)",
+	},
+	mutator.DropStructField: {
+		description: "Removes a keyed field from a composite literal, leaving it to its zero value.",
+		original:    "p := Point{X: 1, Y: 2}",
+		mutated:     "p := Point{Y: 2}",
+	},
+	mutator.ContinueToReturn: {
+		description: "Replaces a continue statement in a loop with a return statement.",
+		original:    "if skip(v) { continue }",
+		mutated:     "if skip(v) { return }",
+	},
+	mutator.RangeCountBoundary: {
+		description: "Changes by one the count of a range over an integer.",
+		original:    "for i := range len(s)",
+		mutated:     "for i := range len(s) - 1",
+	},
+	mutator.DropLogicalOperand: {
+		description: "Drops an operand from a chain of logical AND or OR.",
+		original:    "if a && b && c {",
+		mutated:     "if a && c {",
+	},
+	mutator.SliceBoundary: {
+		description: "Changes by one the low or the high bound of a slice expression.",
+		original:    "head := s[1:n]",
+		mutated:     "head := s[0:n]",
+	},
+	mutator.SwitchCaseValue: {
+		description: "Changes by one an integer literal of a case clause of a switch.",
+		original:    "case 404:",
+		mutated:     "case 405:",
+	},
+	mutator.InjectEarlyReturn: {
+		description: "Inserts a return statement at the start of the body of a function.",
+		original:    "func save(u User) { db.Put(u) }",
+		mutated:     "func save(u User) { return; db.Put(u) }",
+	},
+	mutator.FloatSignFlip: {
+		description: "Negates a float literal, or replaces it with zero.",
+		original:    "ratio := 0.75",
+		mutated:     "ratio := (-0.75)",
+	},
+	mutator.ContextReplace: {
+		description: "Replaces a ctx argument with a new background context, cutting the cancellation and the values it carries.",
+		original:    "db.Query(ctx, q)",
+		mutated:     "db.Query(context.Background(), q)",
+	},
+	mutator.RemoveGoStmt: {
+		description: "Runs the call of a go statement synchronously, instead of in a new goroutine.",
+		original:    "go worker(jobs)",
+		mutated:     "worker(jobs)",
+	},
+	mutator.RemoveElse: {
+		description: "Drops the else branch of an if statement.",
+		original:    "if ok { a() } else { b() }",
+		mutated:     "if ok { a() }",
+	},
+	mutator.CollectionElement: {
+		description: "Replaces a literal element value of a slice, array or map literal with the zero value.",
+		original:    "[]int{1, 2, 3}",
+		mutated:     "[]int{0, 2, 3}",
+	},
+	mutator.StrictnessToggle: {
+		description: "Makes all the comparisons of a function mixing strict and non-strict ones either strict or non-strict.",
+		original:    "if i < n && n <= max { ... }",
+		mutated:     "if i < n && n < max { ... }",
+	},
+	mutator.RemoveDefaultCase: {
+		description: "Removes the default clause of a switch statement.",
+		original:    "switch x { case 1: a(); default: b() }",
+		mutated:     "switch x { case 1: a() }",
+	},
+}
+
+func newExplainCmd() *explainCmd {
+	cmd := &cobra.Command{
+		Use:   "explain <mutant-type>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Explain a mutant type",
+		Long: "Describes a mutant type and shows an example of its mutation. The mutant type is written\n" +
+			"as in its flag, ex. 'conditionals-boundary'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explain(cmd.OutOrStdout(), args[0])
+		},
+	}
+
+	return &explainCmd{cmd: cmd}
+}
+
+func explain(w io.Writer, name string) error {
+	for _, mt := range mutator.Types {
+		if mutantTypeParam(mt) != strings.ToLower(name) {
+			continue
+		}
+		e, ok := explanations[mt]
+		if !ok {
+			e.description = "A custom mutant type."
+		}
+		_, _ = fmt.Fprintf(w, "%s\n\n%s\n", mt, e.description)
+		if ok {
+			_, _ = fmt.Fprintf(w, "\nOriginal:\n\t%s\n\nMutated:\n\t%s\n", e.original, e.mutated)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", ErrUnknownMutantType, name)
+}
+
+// mutantTypeParam returns the name of the mutator.Type as it is used in the
+// flags, ex. conditionals-boundary.
+func mutantTypeParam(mt mutator.Type) string {
+	return strings.ToLower(strings.ReplaceAll(mt.String(), "_", "-"))
+}
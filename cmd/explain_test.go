@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestExplain(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := explain(out, "conditionals-boundary")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "CONDITIONALS_BOUNDARY\n\n" +
+		"Includes or excludes the boundary of a relational operator.\n\n" +
+		"Original:\n\tif a > b {\n\n" +
+		"Mutated:\n\tif a >= b {\n"
+	if !cmp.Equal(out.String(), want) {
+		t.Errorf(cmp.Diff(want, out.String()))
+	}
+}
+
+func TestExplainUnknownType(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := explain(out, "not-a-type")
+	if !errors.Is(err, ErrUnknownMutantType) {
+		t.Errorf("expected %v, got %v", ErrUnknownMutantType, err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected no output, got %q", out.String())
+	}
+}
+
+func TestExplainCoversAllTypes(t *testing.T) {
+	for _, mt := range mutator.Types {
+		if _, ok := explanations[mt]; !ok {
+			t.Errorf("expected %s to have an explanation", mt)
+		}
+	}
+}
+
+func TestExplainCmd(t *testing.T) {
+	c := newExplainCmd()
+	out := &bytes.Buffer{}
+	c.cmd.SetOut(out)
+	c.cmd.SetArgs([]string{"invert-error-check"})
+
+	if err := c.cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Original:\n\tif errors.Is(err, io.EOF) {\n\nMutated:\n\tif !errors.Is(err, io.EOF) {\n"
+	if !bytes.HasSuffix(out.Bytes(), []byte(want)) {
+		t.Errorf("expected output to end with %q, got %q", want, out.String())
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+)
+
+// fileMode returns the file-mode of the written files, which are the mutated
+// files and the workdir copies, or zero if it isn't set. It must be octal
+// permissions, including the write one of the owner, since the mutants
+// overwrite the files. It is passed as an option to the engine.Engine and to
+// the workdir.Dealer.
+func fileMode() (fs.FileMode, error) {
+	value := configuration.Get[string](configuration.UnleashFileModeKey)
+	if value == "" {
+		return 0, nil
+	}
+	mode, err := strconv.ParseUint(value, 8, 32)
+	if err != nil || mode > 0o777 || mode&0o200 == 0 {
+		return 0, fmt.Errorf("invalid file-mode %q, expected octal permissions writable by the owner, ex. 0644", value)
+	}
+
+	return fs.FileMode(mode), nil
+}
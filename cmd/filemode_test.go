@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+)
+
+func TestFileMode(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   string
+		want    fs.FileMode
+		wantErr bool
+	}{
+		{
+			name: "it is zero when not set",
+		},
+		{
+			name:  "it parses the octal permissions",
+			value: "0644",
+			want:  0o644,
+		},
+		{
+			name:  "it parses the permissions without the leading zero",
+			value: "664",
+			want:  0o664,
+		},
+		{
+			name:    "it fails on a non octal value",
+			value:   "0948",
+			wantErr: true,
+		},
+		{
+			name:    "it fails on more than the permissions",
+			value:   "4755",
+			wantErr: true,
+		},
+		{
+			name:    "it fails on files not writable by the owner",
+			value:   "0444",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashFileModeKey, tc.value)
+			defer configuration.Reset()
+
+			got, err := fileMode()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("fileMode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("fileMode() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
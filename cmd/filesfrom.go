@@ -0,0 +1,79 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+)
+
+// filesFrom reads the files to mutate from the files-from list, one per
+// line, which is stdin when it is "-". It returns nil if files-from isn't
+// set, so that all the files are walked.
+// The paths are made relative to the directory Gremlins runs in, and the
+// ones outside of it are dropped.
+func filesFrom(stdin io.Reader, mod gomodule.GoModule) ([]string, error) {
+	from := configuration.Get[string](configuration.UnleashFilesFromKey)
+	if from == "" {
+		return nil, nil
+	}
+	r := stdin
+	if from != "-" {
+		f, err := os.Open(from)
+		if err != nil {
+			return nil, fmt.Errorf("impossible to read the files-from list: %w", err)
+		}
+		defer func(f *os.File) {
+			_ = f.Close()
+		}(f)
+		r = f
+	}
+	base, err := filepath.Abs(filepath.Join(mod.Root, mod.CallingDir))
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		abs, err := filepath.Abs(line)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(base, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		files = append(files, filepath.ToSlash(rel))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("impossible to read the files-from list: %w", err)
+	}
+
+	return files, nil
+}
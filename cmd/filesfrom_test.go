@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+)
+
+func TestFilesFrom(t *testing.T) {
+	listFile := filepath.Join(t.TempDir(), "files.txt")
+	if err := os.WriteFile(listFile, []byte("pkg/b.go\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name       string
+		from       string
+		stdin      string
+		callingDir string
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name: "it is nil when not set",
+		},
+		{
+			name:       "it reads the list from stdin",
+			from:       "-",
+			stdin:      "a.go\n\n  pkg/b.go  \n",
+			callingDir: ".",
+			want:       []string{"a.go", "pkg/b.go"},
+		},
+		{
+			name:       "it reads the list from a file",
+			from:       listFile,
+			callingDir: ".",
+			want:       []string{"pkg/b.go"},
+		},
+		{
+			name:       "it makes the files relative to the calling dir, dropping the ones outside",
+			from:       "-",
+			stdin:      "a.go\npkg/b.go\npkg/sub/c.go\n",
+			callingDir: "pkg",
+			want:       []string{"b.go", "sub/c.go"},
+		},
+		{
+			name:       "it is empty when no file is listed",
+			from:       "-",
+			callingDir: ".",
+			want:       []string{},
+		},
+		{
+			name:    "it fails when the list can't be read",
+			from:    filepath.Join(t.TempDir(), "missing.txt"),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashFilesFromKey, tc.from)
+			defer configuration.Reset()
+			mod := gomodule.GoModule{Name: "example.com", Root: ".", CallingDir: tc.callingDir}
+
+			got, err := filesFrom(strings.NewReader(tc.stdin), mod)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("filesFrom() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -30,7 +30,10 @@ import (
 	"github.com/go-gremlins/gremlins/internal/log"
 )
 
-const paramConfigFile = "config"
+const (
+	paramConfigFile      = "config"
+	paramNoProjectConfig = "no-project-config"
+)
 
 // Execute initialises a new Cobra root command (gremlins) with a custom version
 // string used in the `-v` flag results.
@@ -49,14 +52,16 @@ type gremlinsCmd struct {
 
 func (gc gremlinsCmd) execute() error {
 	var cfgFile string
+	var noProjectConfig bool
 	cobra.OnInitialize(func() {
-		err := configuration.Init([]string{cfgFile})
+		err := configuration.Init([]string{cfgFile}, noProjectConfig)
 		if err != nil {
 			log.Errorf("initialization error: %s\n", err)
 			os.Exit(1)
 		}
 	})
 	gc.cmd.PersistentFlags().StringVar(&cfgFile, paramConfigFile, "", "override config file")
+	gc.cmd.PersistentFlags().BoolVar(&noProjectConfig, paramNoProjectConfig, false, "ignore the config file of the module root and the current directory")
 
 	return gc.cmd.Execute()
 }
@@ -81,6 +86,8 @@ func newRootCmd(ctx context.Context, version string) (*gremlinsCmd, error) {
 
 	}
 	cmd.AddCommand(uc.cmd)
+	cmd.AddCommand(newExplainCmd().cmd)
+	cmd.AddCommand(newReportCmd().cmd)
 
 	flag := &flags.Flag{Name: "silent", CfgKey: configuration.GremlinsSilentKey, Shorthand: "s", DefaultV: false, Usage: "suppress output and run in silent mode"}
 	if err := flags.SetPersistent(cmd, flag); err != nil {
@@ -46,6 +46,17 @@ func TestGremlins(t *testing.T) {
 		t.Errorf("expected default value to be empty, got %v", cfgFile.DefValue)
 	}
 
+	noProjectConfig := cmd.Flag("no-project-config")
+	if noProjectConfig == nil {
+		t.Fatal("expected to have a no-project-config flag")
+	}
+	if noProjectConfig.Value.Type() != boolType {
+		t.Errorf("expected value type to be 'bool', got %v", noProjectConfig.Value.Type())
+	}
+	if noProjectConfig.DefValue != "false" {
+		t.Errorf("expected default value to be false, got %v", noProjectConfig.DefValue)
+	}
+
 	silentFlag := cmd.Flag("silent")
 	if silentFlag == nil {
 		t.Fatal("expected to have a config flag")
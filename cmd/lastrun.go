@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+// lastRunFile is the state file holding the time of the last successful run.
+const lastRunFile = "last-run"
+
+// userCacheDir returns the cache directory of the user, it is a variable so
+// that the tests don't write in the real one.
+var userCacheDir = os.UserCacheDir
+
+// lastRunPath returns the path of the state file of the module: it lives in
+// the gremlins directory of the user cache, in a directory named after the
+// hash of the module root, so that it never pollutes the module.
+func lastRunPath(root string) (string, error) {
+	cache, err := userCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("impossible to find the user cache directory: %w", err)
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(abs))
+
+	return filepath.Join(cache, "gremlins", hex.EncodeToString(sum[:8]), lastRunFile), nil
+}
+
+// sinceLastRun sets changed-since to the time of the last successful run
+// recorded for the module. If no run has been recorded yet, all the files
+// are considered changed.
+func sinceLastRun(root string) error {
+	if configuration.Get[string](configuration.UnleashChangedSinceKey) != "" {
+		return errors.New("since-last-run and changed-since can't be used together")
+	}
+	path, err := lastRunPath(root)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Infof("No previous run recorded, testing all the files\n")
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("impossible to read the last run: %w", err)
+	}
+	last := strings.TrimSpace(string(data))
+	if _, err := time.Parse(time.RFC3339, last); err != nil {
+		return fmt.Errorf("invalid last run %q in %s", last, path)
+	}
+	configuration.Set(configuration.UnleashChangedSinceKey, last)
+
+	return nil
+}
+
+// recordLastRun records the time of the run for the module. The time is the
+// start of the run, so the files modified while it was running are
+// considered changed by the next one.
+func recordLastRun(root string, start time.Time) error {
+	path, err := lastRunPath(root)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("impossible to record the last run: %w", err)
+	}
+	data := []byte(start.UTC().Format(time.RFC3339Nano) + "\n")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("impossible to record the last run: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/diff"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+func TestSinceLastRun(t *testing.T) {
+	log.Init(&bytes.Buffer{}, &bytes.Buffer{})
+	defer log.Reset()
+	defer configuration.Reset()
+	cache := stubUserCacheDir(t)
+	root := t.TempDir()
+	untouched := filepath.Join(root, "untouched.go")
+	touched := filepath.Join(root, "touched.go")
+	for _, f := range []string{untouched, touched} {
+		if err := os.WriteFile(f, []byte("package main\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	last := time.Now()
+	before := last.Add(-time.Hour)
+	_ = os.Chtimes(untouched, before, before)
+	_ = os.Chtimes(touched, before, before)
+
+	if err := recordLastRun(root, last); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(root)
+	if len(entries) != 2 {
+		t.Errorf("expected the last run not to be recorded in the module, got %d files", len(entries))
+	}
+	if _, err := os.Stat(filepath.Join(cache, "gremlins")); err != nil {
+		t.Errorf("expected the last run to be recorded in the user cache: %s", err)
+	}
+	after := last.Add(time.Minute)
+	_ = os.Chtimes(touched, after, after)
+
+	if err := sinceLastRun(root); err != nil {
+		t.Fatal(err)
+	}
+	since, err := diff.NewSince()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		file        string
+		wantChanged bool
+	}{
+		{file: untouched},
+		{file: touched, wantChanged: true},
+	} {
+		info, _ := os.Stat(tc.file)
+		if got := since.IsFileChanged(info.ModTime()); got != tc.wantChanged {
+			t.Errorf("expected %s to be changed: %v, got %v", filepath.Base(tc.file), tc.wantChanged, got)
+		}
+	}
+}
+
+func TestSinceLastRunWithoutRecord(t *testing.T) {
+	log.Init(&bytes.Buffer{}, &bytes.Buffer{})
+	defer log.Reset()
+	defer configuration.Reset()
+	stubUserCacheDir(t)
+
+	if err := sinceLastRun(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := configuration.Get[string](configuration.UnleashChangedSinceKey); got != "" {
+		t.Errorf("expected changed-since not to be set, got %q", got)
+	}
+}
+
+func TestSinceLastRunWithChangedSince(t *testing.T) {
+	configuration.Set(configuration.UnleashChangedSinceKey, "24h")
+	defer configuration.Reset()
+
+	if err := sinceLastRun(t.TempDir()); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestLastRunPath(t *testing.T) {
+	stubUserCacheDir(t)
+
+	first, err := lastRunPath("first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := lastRunPath("first")
+	second, _ := lastRunPath("second")
+
+	if first != again {
+		t.Errorf("expected the same path for the same module, got %q and %q", first, again)
+	}
+	if first == second {
+		t.Errorf("expected different paths for different modules, got %q", first)
+	}
+}
+
+func stubUserCacheDir(t *testing.T) string {
+	t.Helper()
+	cache := t.TempDir()
+	userCacheDir = func() (string, error) { return cache, nil }
+	t.Cleanup(func() { userCacheDir = os.UserCacheDir })
+
+	return cache
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+// tagMatrix returns the build tag sets of the tag-matrix flag, which are
+// separated by semicolons.
+func tagMatrix() []string {
+	var sets []string
+	for _, s := range strings.Split(configuration.Get[string](configuration.UnleashTagMatrixKey), ";") {
+		if s = strings.TrimSpace(s); s != "" {
+			sets = append(sets, s)
+		}
+	}
+
+	return sets
+}
+
+// mergeResults merges the results of the runs with the build tag sets of the
+// matrix. The mutants found by more than one run are de-duplicated by their
+// fingerprint, keeping the first run that covered them, and the tag set of
+// the kept run is recorded in the results.
+func mergeResults(runs []report.Results, sets []string) report.Results {
+	var merged report.Results
+	merged.MutantTags = make(map[string]string)
+	idx := make(map[string]int)
+	for i, res := range runs {
+		if i == 0 {
+			merged.Module = res.Module
+			merged.CallingDir = res.CallingDir
+			merged.Sample = res.Sample
+			merged.Packages = res.Packages
+		}
+		// The coverage is known as soon as one of the runs gathered it.
+		merged.CoverageNotGathered = res.CoverageNotGathered && (i == 0 || merged.CoverageNotGathered)
+		merged.Elapsed += res.Elapsed
+		merged.ExcludedMutants = max(merged.ExcludedMutants, res.ExcludedMutants)
+		merged.PotentialMutants = max(merged.PotentialMutants, res.PotentialMutants)
+
+		for _, m := range res.Mutants {
+			fp := mutator.NewFingerprint(m.Position(), m.Type().String())
+			j, ok := idx[fp]
+			switch {
+			case !ok:
+				idx[fp] = len(merged.Mutants)
+				merged.Mutants = append(merged.Mutants, m)
+			case merged.Mutants[j].Status() == mutator.NotCovered && m.Status() != mutator.NotCovered:
+				merged.Mutants[j] = m
+			default:
+				continue
+			}
+			merged.MutantTags[fp] = sets[i]
+		}
+	}
+
+	return merged
+}
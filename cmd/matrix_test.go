@@ -0,0 +1,123 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+type matrixMutant struct {
+	mutator.Mutator
+	status mutator.Status
+	mType  mutator.Type
+	line   int
+}
+
+func (m matrixMutant) Type() mutator.Type {
+	return m.mType
+}
+
+func (m matrixMutant) Status() mutator.Status {
+	return m.status
+}
+
+func (m matrixMutant) Position() token.Position {
+	return token.Position{Filename: "file.go", Line: m.line, Column: 1}
+}
+
+func TestTagMatrix(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{
+			name:  "it is empty if not set",
+			value: "",
+		},
+		{
+			name:  "it splits the sets on semicolons",
+			value: "integration; linux,cgo ;;",
+			want:  []string{"integration", "linux,cgo"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashTagMatrixKey, tc.value)
+			defer configuration.Reset()
+
+			if got := tagMatrix(); !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestMergeResults(t *testing.T) {
+	sets := []string{"tag1", "tag2"}
+	runs := []report.Results{
+		{
+			Module: "example.com",
+			Mutants: []mutator.Mutator{
+				matrixMutant{status: mutator.Killed, mType: mutator.ArithmeticBase, line: 1},
+				matrixMutant{status: mutator.NotCovered, mType: mutator.ArithmeticBase, line: 2},
+			},
+			Elapsed:          2,
+			PotentialMutants: 3,
+		},
+		{
+			Module: "example.com",
+			Mutants: []mutator.Mutator{
+				matrixMutant{status: mutator.Lived, mType: mutator.ArithmeticBase, line: 1},
+				matrixMutant{status: mutator.Lived, mType: mutator.ArithmeticBase, line: 2},
+				matrixMutant{status: mutator.Killed, mType: mutator.ArithmeticBase, line: 3},
+			},
+			Elapsed:          3,
+			PotentialMutants: 4,
+		},
+	}
+
+	got := mergeResults(runs, sets)
+
+	want := []mutator.Mutator{
+		runs[0].Mutants[0],
+		runs[1].Mutants[1],
+		runs[1].Mutants[2],
+	}
+	if !cmp.Equal(got.Mutants, want, cmp.AllowUnexported(matrixMutant{})) {
+		t.Errorf(cmp.Diff(want, got.Mutants, cmp.AllowUnexported(matrixMutant{})))
+	}
+	wantTags := map[string]string{
+		mutator.NewFingerprint(want[0].Position(), "ARITHMETIC_BASE"): "tag1",
+		mutator.NewFingerprint(want[1].Position(), "ARITHMETIC_BASE"): "tag2",
+		mutator.NewFingerprint(want[2].Position(), "ARITHMETIC_BASE"): "tag2",
+	}
+	if !cmp.Equal(got.MutantTags, wantTags) {
+		t.Errorf(cmp.Diff(wantTags, got.MutantTags))
+	}
+	if got.Module != "example.com" || got.Elapsed != 5 || got.PotentialMutants != 4 {
+		t.Errorf("unexpected merged results: %+v", got)
+	}
+}
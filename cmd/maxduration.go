@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+// withMaxDuration derives a context which is cancelled once the run exceeds
+// the max-duration, if set. The mutants tested until then are reported as
+// the partial results of the run. The returned function releases the
+// context, and warns if the max-duration has been reached.
+func withMaxDuration(ctx context.Context) (context.Context, func(), error) {
+	value := configuration.Get[string](configuration.UnleashMaxDurationKey)
+	if value == "" {
+		return ctx, func() {}, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return nil, nil, fmt.Errorf("invalid max-duration %q, expected a positive duration", value)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d)
+
+	return ctx, func() {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			log.Warnf("max duration of %s reached, reporting the partial results\n", d)
+		}
+		cancel()
+	}, nil
+}
+
+// checkTestBudget checks that the total-test-budget, if set, is a positive
+// duration. The budget itself is enforced by the engine.Engine.
+func checkTestBudget() error {
+	value := configuration.Get[string](configuration.UnleashTotalTestBudgetKey)
+	if value == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		return fmt.Errorf("invalid total-test-budget %q, expected a positive duration", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+func TestWithMaxDuration(t *testing.T) {
+	testCases := []struct {
+		name         string
+		value        string
+		wantErr      bool
+		wantDeadline bool
+	}{
+		{
+			name: "it doesn't limit the run if not set",
+		},
+		{
+			name:         "it limits the run to the duration",
+			value:        "1ms",
+			wantDeadline: true,
+		},
+		{
+			name:    "it fails on an invalid duration",
+			value:   "ten minutes",
+			wantErr: true,
+		},
+		{
+			name:    "it fails on a negative duration",
+			value:   "-1m",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, eOut)
+			defer log.Reset()
+			configuration.Set(configuration.UnleashMaxDurationKey, tc.value)
+			defer configuration.Reset()
+
+			ctx, stop, err := withMaxDuration(context.Background())
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("withMaxDuration() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if _, ok := ctx.Deadline(); ok != tc.wantDeadline {
+				t.Fatalf("expected a deadline: %v, got %v", tc.wantDeadline, ok)
+			}
+			if !tc.wantDeadline {
+				stop()
+
+				return
+			}
+			<-ctx.Done()
+			stop()
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				t.Errorf("expected the deadline to be exceeded, got %v", ctx.Err())
+			}
+			if got := eOut.String(); !strings.Contains(got, "max duration of 1ms reached") {
+				t.Errorf("expected the partial results warning, got %q", got)
+			}
+		})
+	}
+}
+
+func TestCheckTestBudget(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name: "it accepts an empty budget",
+		},
+		{
+			name:  "it accepts a positive duration",
+			value: "30m",
+		},
+		{
+			name:    "it fails on an invalid duration",
+			value:   "an hour",
+			wantErr: true,
+		},
+		{
+			name:    "it fails on a zero duration",
+			value:   "0s",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashTotalTestBudgetKey, tc.value)
+			defer configuration.Reset()
+
+			if err := checkTestBudget(); (err != nil) != tc.wantErr {
+				t.Errorf("checkTestBudget() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
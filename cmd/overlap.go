@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-gremlins/gremlins/internal/coverage"
+	"github.com/go-gremlins/gremlins/internal/engine"
+	"github.com/go-gremlins/gremlins/internal/engine/workdir"
+	"github.com/go-gremlins/gremlins/internal/engine/workerpool"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+// runOverlapped performs the mutation testing discovering the mutants while
+// the coverage is being gathered. The engine waits for the coverage before
+// assigning the first status, and the executors before being created, since
+// their timeout depends on the duration of the coverage run.
+//
+// If the coverage fails, the mutants are all NOT COVERED, so none is tested,
+// and the error is returned once the engine is done.
+func runOverlapped(ctx context.Context, mod gomodule.GoModule, wdDealer workdir.Dealer, codeData engine.CodeData, gather func() (coverage.Result, error)) (report.Results, error) {
+	wait := sync.OnceValues(gather)
+	go func() {
+		_, _ = wait()
+	}()
+
+	jDealer := &coverageDealer{wait: wait, mod: mod, wdDealer: wdDealer}
+	fMode, _ := fileMode()
+	mut := engine.New(mod, codeData, jDealer, engine.WithWorkDir(wdDealer.WorkDir()), engine.WithFileMode(fMode), engine.WithPendingCoverage(func() coverage.Profile {
+		res, _ := wait()
+
+		return res.Profile
+	}))
+	results := mut.Run(ctx)
+	if _, err := wait(); err != nil {
+		return report.Results{}, err
+	}
+
+	return results, nil
+}
+
+// coverageDealer is an engine.ExecutorDealer which waits for the coverage to
+// create the engine.MutantExecutorDealer of the executors.
+type coverageDealer struct {
+	wait     func() (coverage.Result, error)
+	wdDealer workdir.Dealer
+	dealer   *engine.MutantExecutorDealer
+	mod      gomodule.GoModule
+	once     sync.Once
+}
+
+func (d *coverageDealer) NewExecutor(mut mutator.Mutator, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) workerpool.Executor {
+	d.once.Do(func() {
+		res, _ := d.wait()
+		d.dealer = engine.NewExecutorDealer(d.mod, d.wdDealer, res.Elapsed)
+	})
+
+	return d.dealer.NewExecutor(mut, outCh, wg)
+}
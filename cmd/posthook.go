@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+// postHook runs the post-hook command, if set, after the report has been
+// generated. The command receives the path of the output file as its last
+// argument and in the GREMLINS_OUTPUT environment variable.
+//
+// The command is run through the shell, sh on Unix and cmd on Windows, so
+// quoted arguments and paths with spaces work as they do on the command
+// line.
+//
+// A failure of the command is only logged, unless fail-on-post-hook is set.
+func postHook(cmdContext execContext) error {
+	hook := configuration.Get[string](configuration.UnleashPostHookKey)
+	if hook == "" {
+		return nil
+	}
+	output, _ := filepath.Abs(configuration.Get[string](configuration.UnleashOutputKey))
+	name, args := shellCommand(hook, output)
+	cmd := cmdContext(name, args...)
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	cmd.Env = append(cmd.Env, "GREMLINS_OUTPUT="+output)
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if configuration.Get[bool](configuration.UnleashFailOnPostHookKey) {
+		return fmt.Errorf("the post-hook failed: %w\n%s", err, out)
+	}
+	log.Errorf("the post-hook failed: %s\n%s", err, out)
+
+	return nil
+}
+
+// shellCommand returns the shell invocation running the hook with the
+// output path as its last argument. On Unix, the path is passed as a
+// positional parameter, so it needs no quoting.
+func shellCommand(hook, output string) (string, []string) {
+	if runtime.GOOS == "windows" {
+		return "cmd", []string{"/C", hook + ` "` + output + `"`}
+	}
+
+	return "sh", []string{"-c", hook + ` "$@"`, "sh", output}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+func TestPostHook(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "output.json")
+	testCases := []struct {
+		name        string
+		process     string
+		failOnHook  bool
+		wantErr     bool
+		wantErrLogs bool
+	}{
+		{
+			name:    "it runs the hook with the output path",
+			process: "TestBuildProcessSuccess",
+		},
+		{
+			name:        "it logs the failure of the hook",
+			process:     "TestBuildProcessFailure",
+			wantErrLogs: true,
+		},
+		{
+			name:       "it fails if the hook fails and fail-on-post-hook is set",
+			process:    "TestBuildProcessFailure",
+			failOnHook: true,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, eOut)
+			defer log.Reset()
+			configuration.Set(configuration.UnleashPostHookKey, `upload --team="core team"`)
+			configuration.Set(configuration.UnleashOutputKey, output)
+			configuration.Set(configuration.UnleashFailOnPostHookKey, tc.failOnHook)
+			defer configuration.Reset()
+			var cmd *exec.Cmd
+			fake := fakeExecCommand(tc.process)
+			cmdContext := func(command string, args ...string) *exec.Cmd {
+				cmd = fake(command, args...)
+
+				return cmd
+			}
+
+			err := postHook(cmdContext)
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("postHook() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if (eOut.Len() > 0) != tc.wantErrLogs {
+				t.Errorf("expected error logs: %v, got %q", tc.wantErrLogs, eOut.String())
+			}
+			if cmd == nil {
+				t.Fatal("expected the hook to be invoked")
+			}
+			wantArgs := []string{"sh", "-c", `upload --team="core team" "$@"`, "sh", output}
+			if runtime.GOOS == "windows" {
+				wantArgs = []string{"cmd", "/C", `upload --team="core team" "` + output + `"`}
+			}
+			if got := cmd.Args[len(cmd.Args)-len(wantArgs):]; !slices.Equal(got, wantArgs) {
+				t.Errorf("expected the hook to be invoked with %v, got %v", wantArgs, got)
+			}
+			if !slices.Contains(cmd.Env, "GREMLINS_OUTPUT="+output) {
+				t.Errorf("expected GREMLINS_OUTPUT to be set, got %v", cmd.Env)
+			}
+		})
+	}
+}
+
+func TestPostHookNotSet(t *testing.T) {
+	invoked := false
+	cmdContext := func(command string, args ...string) *exec.Cmd {
+		invoked = true
+
+		return exec.Command(command, args...)
+	}
+
+	if err := postHook(cmdContext); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if invoked {
+		t.Error("expected the hook not to be invoked")
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+)
+
+// startProfiling starts the CPU profiling of gremlins itself, if a file is
+// set with the pprof-cpu flag. It returns the function that stops the CPU
+// profiling and writes the heap profile, if a file is set with the pprof-mem
+// flag.
+func startProfiling() (func(), error) {
+	cpuFile := configuration.Get[string](configuration.UnleashPprofCPUKey)
+	memFile := configuration.Get[string](configuration.UnleashPprofMemKey)
+
+	var cpu *os.File
+	if cpuFile != "" {
+		f, err := os.Create(cpuFile)
+		if err != nil {
+			return nil, fmt.Errorf("impossible to create the CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			_ = f.Close()
+
+			return nil, fmt.Errorf("impossible to start the CPU profile: %w", err)
+		}
+		cpu = f
+	}
+
+	return func() {
+		if cpu != nil {
+			pprof.StopCPUProfile()
+			if err := cpu.Close(); err != nil {
+				log.Errorf("impossible to write the CPU profile: %s\n", err)
+			}
+		}
+		if memFile != "" {
+			if err := writeHeapProfile(memFile); err != nil {
+				log.Errorf("impossible to write the memory profile: %s\n", err)
+			}
+		}
+	}, nil
+}
+
+func writeHeapProfile(fileName string) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	// Get up-to-date statistics of the allocations.
+	runtime.GC()
+
+	return pprof.WriteHeapProfile(f)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+)
+
+func TestProfiling(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfgKey string
+	}{
+		{
+			name:   "it writes the CPU profile",
+			cfgKey: configuration.UnleashPprofCPUKey,
+		},
+		{
+			name:   "it writes the memory profile",
+			cfgKey: configuration.UnleashPprofMemKey,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "profile.pprof")
+			configuration.Set[string](tc.cfgKey, fileName)
+			defer configuration.Reset()
+
+			stop, err := startProfiling()
+			if err != nil {
+				t.Fatal(err)
+			}
+			stop()
+
+			assertValidProfile(t, fileName)
+		})
+	}
+}
+
+func TestProfilingFailsIfFileCantBeCreated(t *testing.T) {
+	configuration.Set[string](configuration.UnleashPprofCPUKey, filepath.Join(t.TempDir(), "missing", "cpu.pprof"))
+	defer configuration.Reset()
+
+	_, err := startProfiling()
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+// assertValidProfile checks that the file is a gzipped protocol buffer, as
+// written by runtime/pprof.
+func assertValidProfile(t *testing.T, fileName string) {
+	t.Helper()
+	f, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("expected the profile to be created: %s", err)
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("expected a gzipped profile: %s", err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expected a gzipped profile: %s", err)
+	}
+	if len(data) == 0 {
+		t.Errorf("expected a non empty profile")
+	}
+}
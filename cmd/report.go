@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+const (
+	paramReportFrom   = "from"
+	paramReportFormat = "format"
+)
+
+type reportCmd struct {
+	cmd *cobra.Command
+}
+
+func newReportCmd() *reportCmd {
+	var from, format string
+	cmd := &cobra.Command{
+		Use:   "report --from <json>",
+		Args:  cobra.NoArgs,
+		Short: "Render the JSON output of a previous run",
+		Long: "Renders the JSON output file of a previous run of unleash in another format, without running\n" +
+			"the mutants again. The report is written on stdout.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return report.Render(cmd.OutOrStdout(), from, format)
+		},
+	}
+	cmd.Flags().StringVar(&from, paramReportFrom, "", "the JSON output file of a previous run")
+	cmd.Flags().StringVar(&format, paramReportFormat, "junit", fmt.Sprintf("the format of the report (%s)", strings.Join(report.Formats, ", ")))
+	_ = cmd.MarkFlagRequired(paramReportFrom)
+
+	return &reportCmd{cmd: cmd}
+}
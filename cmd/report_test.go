@@ -0,0 +1,62 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReportCmd(t *testing.T) {
+	from := filepath.Join(t.TempDir(), "findings.json")
+	findings := `{"go_module":"example.com/go/module","files":[{"file_name":"file1.go","mutations":[` +
+		`{"type":"ARITHMETIC_BASE","status":"LIVED","line":8,"column":20},` +
+		`{"type":"CONDITIONALS_NEGATION","status":"KILLED","line":3,"column":10}]}]}`
+	if err := os.WriteFile(from, []byte(findings), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newReportCmd()
+	out := &bytes.Buffer{}
+	c.cmd.SetOut(out)
+	c.cmd.SetArgs([]string{"--from", from, "--format", "tap"})
+
+	if err := c.cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "TAP version 13\n" +
+		"1..2\n" +
+		"ok 1 - CONDITIONALS_NEGATION at file1.go:3:10\n" +
+		"not ok 2 - ARITHMETIC_BASE at file1.go:8:20 # LIVED\n"
+	if out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
+
+func TestReportCmdRequiresFrom(t *testing.T) {
+	c := newReportCmd()
+	c.cmd.SetOut(&bytes.Buffer{})
+	c.cmd.SetErr(&bytes.Buffer{})
+	c.cmd.SetArgs([]string{"--format", "junit"})
+
+	if err := c.cmd.Execute(); err == nil {
+		t.Error("expected an error without --from")
+	}
+}
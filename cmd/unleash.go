@@ -20,8 +20,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
@@ -32,6 +36,7 @@ import (
 	"github.com/go-gremlins/gremlins/internal/engine"
 	"github.com/go-gremlins/gremlins/internal/engine/workdir"
 	"github.com/go-gremlins/gremlins/internal/exclusion"
+	"github.com/go-gremlins/gremlins/internal/execution"
 	"github.com/go-gremlins/gremlins/internal/log"
 	"github.com/go-gremlins/gremlins/internal/mutator"
 	"github.com/go-gremlins/gremlins/internal/report"
@@ -49,20 +54,81 @@ const (
 	commandName = "unleash"
 
 	paramDiff               = "diff"
+	paramDiffFunctions      = "diff-functions"
+	paramChangedSince       = "changed-since"
+	paramFilesFrom          = "files-from"
+	paramSinceLastRun       = "since-last-run"
+	paramRetryLived         = "retry-lived"
+	paramSample             = "sample"
+	paramSampleSeed         = "sample-seed"
+	paramOnePerLine         = "one-per-line"
+	paramFlagInit           = "flag-init"
+	paramExportedOnly       = "exported-only"
+	paramDedupePerPosition  = "dedupe-per-position"
+	paramDedupePriority     = "dedupe-priority"
 	paramBuildTags          = "tags"
+	paramTagMatrix          = "tag-matrix"
 	paramCoverPackages      = "coverpkg"
+	paramCoverProfileFiles  = "cover-profile-file"
+	paramBestEffortCov      = "best-effort-coverage"
+	paramCoverageCache      = "coverage-cache"
+	paramNoCoverage         = "no-coverage"
+	paramNoSharedAST        = "no-shared-ast"
+	paramSelfCheck          = "self-check"
+	paramOverlapCoverage    = "overlap-coverage"
+	paramDumpCoverage       = "dump-coverage"
+	paramDumpMutant         = "dump-mutant"
+	paramDumpMutantOut      = "dump-mutant-output"
 	paramDryRun             = "dry-run"
 	paramOutputStatuses     = "output-statuses"
 	paramOutput             = "output"
+	paramOutputFormat       = "output-format"
+	paramGroupBy            = "group-by"
+	paramSortBy             = "sort-by"
+	paramHotspots           = "hotspots"
+	paramJSONStdout         = "json-stdout"
+	paramTAPStdout          = "tap-stdout"
+	paramLivedDiff          = "lived-diff"
+	paramLogFunction        = "log-function"
+	paramModuleRootPaths    = "module-root-paths"
+	paramImportPaths        = "import-paths"
 	paramIntegrationMode    = "integration"
+	paramSkipBuildCheck     = "skip-build-check"
+	paramGreenBaseline      = "require-green-baseline"
+	paramIsolateGoCache     = "isolate-gocache"
+	paramBuildCacheDir      = "build-cache-dir"
+	paramPprofCPU           = "pprof-cpu"
+	paramPprofMem           = "pprof-mem"
 	paramExcludeFiles       = "exclude-files"
+	paramGeneratedDirs      = "generated-dirs"
+	paramExcludeLineRegex   = "exclude-line-regex"
+	paramSuppress           = "suppress"
 	paramTestCPU            = "test-cpu"
+	paramTestJSON           = "test-json"
 	paramWorkers            = "workers"
+	paramMaxFileWrites      = "max-file-writes"
+	paramMaxMutantsPerFile  = "max-mutants-per-file"
+	paramWriteRetries       = "write-retries"
+	paramFileMode           = "file-mode"
+	paramSerializePkgs      = "serialize-packages"
 	paramTimeoutCoefficient = "timeout-coefficient"
+	paramStrict             = "strict"
+	paramFailOnNoCoverage   = "fail-on-no-coverage"
+	paramFailFast           = "fail-fast"
+	paramConfirmKills       = "confirm-kills"
+	paramNotViableRetries   = "not-viable-retries"
+	paramMaxDuration        = "max-duration"
+	paramTotalTestBudget    = "total-test-budget"
+	paramWarnExcluded       = "warn-excluded"
+	paramFailOnExcluded     = "fail-on-excluded"
+	paramPostHook           = "post-hook"
+	paramFailOnPostHook     = "fail-on-post-hook"
+	paramWebhookURL         = "webhook-url"
 
 	// Thresholds.
 	paramThresholdEfficacy  = "threshold-efficacy"
 	paramThresholdMCoverage = "threshold-mcover"
+	paramThresholdNotViable = "threshold-not-viable"
 )
 
 func newUnleashCmd(ctx context.Context) (*unleashCmd, error) {
@@ -98,11 +164,28 @@ func longExplainer() string {
 		if those values are not met. Efficacy is the percent of KILLED mutants over
 		the total KILLED and LIVED mutants. Mutant coverage is the percent of total
 		KILLED + LIVED mutants, over the total mutants.
+
+		In 'strict' mode, gremlins also exits with an error if the percent of NOT VIABLE
+		mutants over the tested mutants is above the not-viable threshold.
 	`)
 }
 
 func runUnleash(ctx context.Context) func(cmd *cobra.Command, args []string) error {
 	return func(_ *cobra.Command, args []string) error {
+		jsonStdout := configuration.Get[bool](configuration.UnleashJSONStdoutKey)
+		tapStdout := configuration.Get[bool](configuration.UnleashTAPStdoutKey)
+		if jsonStdout && tapStdout {
+			return fmt.Errorf("%s and %s can't be used together", paramJSONStdout, paramTAPStdout)
+		}
+		if jsonStdout || tapStdout {
+			// Keep stdout clean for the machine readable results.
+			configuration.Set(configuration.GremlinsSilentKey, true)
+		}
+		stopProfiling, err := startProfiling()
+		if err != nil {
+			return err
+		}
+		defer stopProfiling()
 		log.Infoln("Starting...")
 		path, _ := os.Getwd()
 		if len(args) > 0 {
@@ -119,6 +202,7 @@ func runUnleash(ctx context.Context) func(cmd *cobra.Command, args []string) err
 		}
 		defer cleanUp(workDir)
 
+		start := time.Now()
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
 		cancelled := false
@@ -136,7 +220,19 @@ func runUnleash(ctx context.Context) func(cmd *cobra.Command, args []string) err
 			return nil
 		}
 
-		return report.Do(results)
+		err = report.Do(results)
+		notifyWebhook(webhookClient, results)
+		if hookErr := postHook(exec.Command); hookErr != nil {
+			return hookErr
+		}
+		if err != nil {
+			return err
+		}
+		if configuration.Get[bool](configuration.UnleashSinceLastRunKey) {
+			return recordLastRun(mod.Root, start)
+		}
+
+		return nil
 	}
 }
 
@@ -159,40 +255,273 @@ func cleanUp(wd string) {
 }
 
 func run(ctx context.Context, mod gomodule.GoModule, workDir string) (report.Results, error) {
+	ctx, stop, err := withMaxDuration(ctx)
+	if err != nil {
+		return report.Results{}, err
+	}
+	defer stop()
+
 	fDiff, err := diff.New()
 	if err != nil {
 		return report.Results{}, err
 	}
 
-	c := coverage.New(workDir, mod)
+	if configuration.Get[bool](configuration.UnleashSinceLastRunKey) {
+		if err := sinceLastRun(mod.Root); err != nil {
+			return report.Results{}, err
+		}
+	}
+	since, err := diff.NewSince()
+	if err != nil {
+		return report.Results{}, err
+	}
 
-	exclude, err := exclusion.New()
+	if s := configuration.Get[float64](configuration.UnleashSampleKey); s < 0 || s > 1 {
+		return report.Results{}, fmt.Errorf("invalid sample %v, it must be between 0 and 1", s)
+	}
+
+	if g := configuration.Get[string](configuration.UnleashGroupByKey); g != "" && g != report.GroupByType {
+		return report.Results{}, fmt.Errorf("invalid group-by %q, the only allowed value is %q", g, report.GroupByType)
+	}
+	if s := configuration.Get[string](configuration.UnleashSortByKey); s != "" && s != report.SortBySuspicion {
+		return report.Results{}, fmt.Errorf("invalid sort-by %q, the only allowed value is %q", s, report.SortBySuspicion)
+	}
+	switch f := configuration.Get[string](configuration.UnleashOutputFormatKey); f {
+	case "", report.OutputFormatJSON, report.OutputFormatSQLite, report.OutputFormatCodeQuality:
+	default:
+		return report.Results{}, fmt.Errorf("invalid output-format %q, allowed values are %q, %q and %q", f, report.OutputFormatJSON, report.OutputFormatSQLite, report.OutputFormatCodeQuality)
+	}
+	if err := buildCacheDir(); err != nil {
+		return report.Results{}, err
+	}
+	if err := checkTestBudget(); err != nil {
+		return report.Results{}, err
+	}
+	if _, err := fileMode(); err != nil {
+		return report.Results{}, err
+	}
+	if configuration.Get[string](configuration.UnleashPostHookKey) != "" && configuration.Get[string](configuration.UnleashOutputKey) == "" {
+		return report.Results{}, fmt.Errorf("the post-hook needs the output file, set it with --%s", paramOutput)
+	}
+
+	var only mutator.Fingerprints
+	if retry := configuration.Get[string](configuration.UnleashRetryLivedKey); retry != "" {
+		only, err = report.LivedFingerprints(retry, mod.CallingDir)
+		if err != nil {
+			return report.Results{}, err
+		}
+	}
+
+	suppressed, err := report.SuppressedFingerprints(mod.Name, mod.CallingDir)
 	if err != nil {
 		return report.Results{}, err
 	}
 
-	cProfile, err := c.Run()
+	exclude, err := exclusion.New()
 	if err != nil {
-		return report.Results{}, fmt.Errorf("failed to gather coverage: %w", err)
+		return report.Results{}, err
 	}
 
-	wdDealer := workdir.NewCachedDealer(workDir, mod.Root)
-	defer wdDealer.Clean()
+	var excludedLines *regexp.Regexp
+	if s := configuration.Get[string](configuration.UnleashExcludeLineRegexKey); s != "" {
+		excludedLines, err = regexp.Compile(s)
+		if err != nil {
+			return report.Results{}, fmt.Errorf("error in %s param value: %w", paramExcludeLineRegex, err)
+		}
+	}
 
-	jDealer := engine.NewExecutorDealer(mod, wdDealer, cProfile.Elapsed)
+	priority, err := dedupePriority()
+	if err != nil {
+		return report.Results{}, err
+	}
+
+	files, err := filesFrom(os.Stdin, mod)
+	if err != nil {
+		return report.Results{}, err
+	}
 
 	codeData := engine.CodeData{
-		Cov:       cProfile.Profile,
 		Diff:      fDiff,
+		Since:     since,
 		Exclusion: exclude,
+		Only:      only,
+
+		Suppressed:    suppressed,
+		GeneratedDirs: exclusion.GeneratedDirs(),
+		ExcludedLines: excludedLines,
+
+		DedupePriority: priority,
+		Files:          files,
+	}
+
+	matrix := tagMatrix()
+	if len(matrix) == 0 {
+		return runOnce(ctx, mod, workDir, codeData)
+	}
+	runs := make([]report.Results, 0, len(matrix))
+	for _, tags := range matrix {
+		if ctx.Err() != nil {
+			break
+		}
+		log.Infof("Running with the build tags %q\n", tags)
+		configuration.Set(configuration.UnleashTagsKey, tags)
+		res, err := runOnce(ctx, mod, workDir, codeData)
+		if err != nil {
+			return report.Results{}, err
+		}
+		runs = append(runs, res)
+	}
+
+	return mergeResults(runs, matrix), nil
+}
+
+// runOnce gathers the coverage and performs the mutation testing with the
+// configured build tags.
+func runOnce(ctx context.Context, mod gomodule.GoModule, workDir string, codeData engine.CodeData) (report.Results, error) {
+	if err := buildCheck(exec.Command, mod); err != nil {
+		return report.Results{}, err
+	}
+	if err := baselineCheck(exec.Command, mod); err != nil {
+		return report.Results{}, err
+	}
+
+	// The path is made absolute, since the coverage run changes the current
+	// directory.
+	dumpPath := configuration.Get[string](configuration.UnleashDumpCoverageKey)
+	if dumpPath != "" {
+		dumpPath, _ = filepath.Abs(dumpPath)
+	}
+
+	c := coverage.New(workDir, mod)
+	noCoverage := configuration.Get[bool](configuration.UnleashNoCoverageKey)
+	gather := func() (coverage.Result, error) {
+		return gatherCoverage(c, dumpPath, noCoverage)
+	}
+
+	fMode, _ := fileMode()
+	wdDealer := workdir.NewCachedDealer(workDir, mod.Root, workdir.WithFileMode(fMode))
+	defer wdDealer.Clean()
+
+	codeData.CoverageDisabled = noCoverage
+
+	if configuration.Get[bool](configuration.UnleashOverlapCovKey) {
+		return runOverlapped(ctx, mod, wdDealer, codeData, gather)
 	}
 
-	mut := engine.New(mod, codeData, jDealer)
+	cProfile, err := gather()
+	if err != nil {
+		return report.Results{}, err
+	}
+
+	jDealer := engine.NewExecutorDealer(mod, wdDealer, cProfile.Elapsed)
+
+	codeData.Cov = cProfile.Profile
+
+	mut := engine.New(mod, codeData, jDealer, engine.WithWorkDir(wdDealer.WorkDir()), engine.WithFileMode(fMode))
 	results := mut.Run(ctx)
 
 	return results, nil
 }
 
+// buildCacheDir makes the build cache directory absolute and creates it, if
+// it is set, since the go command requires an absolute GOCACHE and the
+// coverage changes the current directory.
+func buildCacheDir() error {
+	dir := configuration.Get[string](configuration.UnleashBuildCacheDirKey)
+	if dir == "" {
+		return nil
+	}
+	if configuration.Get[bool](configuration.UnleashIsolateGoCacheKey) {
+		return fmt.Errorf("%s and %s can't be used together", paramBuildCacheDir, paramIsolateGoCache)
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("invalid build-cache-dir %q: %w", dir, err)
+	}
+	if err := os.MkdirAll(abs, 0o700); err != nil {
+		return fmt.Errorf("impossible to create the build cache dir: %w", err)
+	}
+	configuration.Set(configuration.UnleashBuildCacheDirKey, abs)
+
+	return nil
+}
+
+// gatherCoverage runs the coverage and checks its profile, dumping it if
+// configured.
+func gatherCoverage(c *coverage.Coverage, dumpPath string, noCoverage bool) (coverage.Result, error) {
+	cProfile, err := c.Run()
+	if err != nil {
+		return coverage.Result{}, fmt.Errorf("failed to gather coverage: %w", err)
+	}
+	if dumpPath != "" {
+		if err := dumpCoverage(dumpPath, cProfile.Profile); err != nil {
+			return coverage.Result{}, err
+		}
+	}
+	if !noCoverage {
+		if err := checkCoverage(cProfile.Profile); err != nil {
+			return coverage.Result{}, err
+		}
+	}
+
+	return cProfile, nil
+}
+
+// dumpCoverage writes the coverage profile as JSON, to debug the statuses of
+// the mutants.
+func dumpCoverage(path string, p coverage.Profile) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("impossible to dump the coverage: %w", err)
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	if err := p.WriteJSON(f); err != nil {
+		return fmt.Errorf("impossible to dump the coverage: %w", err)
+	}
+
+	return nil
+}
+
+type execContext = func(name string, args ...string) *exec.Cmd
+
+// buildCheck builds the module before the mutation testing. If the module
+// doesn't build, all the mutants would be NOT VIABLE, so it is better to stop
+// early.
+func buildCheck(cmdContext execContext, mod gomodule.GoModule) error {
+	if configuration.Get[bool](configuration.UnleashSkipBuildCheckKey) {
+		return nil
+	}
+	args := []string{"build"}
+	if tags := configuration.Get[string](configuration.UnleashTagsKey); tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	args = append(args, "./...")
+	cmd := cmdContext("go", args...)
+	cmd.Dir = mod.Root
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("the module doesn't build, fix it or use --%s: %w\n%s", paramSkipBuildCheck, err, out)
+	}
+
+	return nil
+}
+
+// checkCoverage warns when the coverage profile is empty, which usually means
+// that the module has no tests. If configured, it also fails the run.
+func checkCoverage(p coverage.Profile) error {
+	if len(p) > 0 {
+		return nil
+	}
+	log.Warnf("no test coverage detected; all mutants will be NOT COVERED\n")
+	if configuration.Get[bool](configuration.UnleashFailOnNoCoverageKey) {
+		return execution.NewExitErr(execution.NoCoverage)
+	}
+
+	return nil
+}
+
 func setFlagsOnCmd(cmd *cobra.Command) error {
 	cmd.Flags().SortFlags = false
 	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -209,15 +538,76 @@ func setFlagsOnCmd(cmd *cobra.Command) error {
 		{Name: paramDryRun, CfgKey: configuration.UnleashDryRunKey, Shorthand: "d", DefaultV: false, Usage: "find mutations but do not executes tests"},
 		{Name: paramOutputStatuses, CfgKey: configuration.UnleashOutputStatusesKey, Shorthand: "S", DefaultV: "", Usage: "print only statuses from this flag, allowed values - 'lctkvsr'"},
 		{Name: paramBuildTags, CfgKey: configuration.UnleashTagsKey, Shorthand: "t", DefaultV: "", Usage: "a comma-separated list of build tags"},
+		{Name: paramTagMatrix, CfgKey: configuration.UnleashTagMatrixKey, DefaultV: "", Usage: "a semicolon-separated list of build tag sets, to run once per set"},
 		{Name: paramCoverPackages, CfgKey: configuration.UnleashCoverPkgKey, DefaultV: "", Usage: "a comma-separated list of package patterns"},
+		{Name: paramCoverProfileFiles, CfgKey: configuration.UnleashCoverProfileFilesKey, DefaultV: []string{}, Usage: "an additional coverage profile file to merge with the gathered coverage"},
+		{Name: paramBestEffortCov, CfgKey: configuration.UnleashBestEffortCoverageKey, DefaultV: false, Usage: "log the packages failing the coverage and report their mutants as NOT COVERED instead of failing"},
+		{Name: paramCoverageCache, CfgKey: configuration.UnleashCoverageCacheKey, DefaultV: false, Usage: "reuse the cached test results when gathering the coverage, instead of running the tests again"},
+		{Name: paramNoCoverage, CfgKey: configuration.UnleashNoCoverageKey, DefaultV: false, Usage: "test all the mutants without using the coverage"},
+		{Name: paramDumpCoverage, CfgKey: configuration.UnleashDumpCoverageKey, DefaultV: "", Usage: "dump the gathered coverage profile to a JSON file"},
+		{Name: paramDumpMutant, CfgKey: configuration.UnleashDumpMutantKey, DefaultV: "", Usage: "dump the mutated source of the mutant at this 'file:line:column' position"},
+		{Name: paramDumpMutantOut, CfgKey: configuration.UnleashDumpMutantOutKey, DefaultV: "", Usage: "write the dumped mutated source to this file, instead of logging it"},
 		{Name: paramDiff, CfgKey: configuration.UnleashDiffRef, Shorthand: "D", DefaultV: "", Usage: "diff branch or commit"},
+		{Name: paramDiffFunctions, CfgKey: configuration.UnleashDiffFunctionsKey, DefaultV: false, Usage: "test all the mutants of the functions changed by the diff"},
+		{Name: paramChangedSince, CfgKey: configuration.UnleashChangedSinceKey, DefaultV: "", Usage: "mutate only files modified since a duration ago or a timestamp"},
+		{Name: paramFilesFrom, CfgKey: configuration.UnleashFilesFromKey, DefaultV: "", Usage: "mutate only the files listed one per line in this file, or in stdin if '-'"},
+		{Name: paramSinceLastRun, CfgKey: configuration.UnleashSinceLastRunKey, DefaultV: false, Usage: "mutate only files modified since the last successful run"},
+		{Name: paramRetryLived, CfgKey: configuration.UnleashRetryLivedKey, DefaultV: "", Usage: "test only the LIVED mutants of a previous output file"},
+		{Name: paramSample, CfgKey: configuration.UnleashSampleKey, DefaultV: float64(0), Usage: "the fraction of covered mutants to randomly test, between 0 and 1"},
+		{Name: paramSampleSeed, CfgKey: configuration.UnleashSampleSeedKey, DefaultV: 0, Usage: "the seed of the random sampling of mutants"},
+		{Name: paramNoSharedAST, CfgKey: configuration.UnleashNoSharedASTKey, DefaultV: false, Usage: "parse the file again for each mutant, instead of sharing the AST among the mutants of a file"},
+		{Name: paramSelfCheck, CfgKey: configuration.UnleashSelfCheckKey, DefaultV: false, Usage: "verify that each mutant changes the source, skipping the ones that don't as bugs of Gremlins"},
+		{Name: paramOverlapCoverage, CfgKey: configuration.UnleashOverlapCovKey, DefaultV: false, Usage: "discover the mutants while the coverage is being gathered"},
+		{Name: paramOnePerLine, CfgKey: configuration.UnleashOnePerLineKey, DefaultV: false, Usage: "find only the first mutant of each source line"},
+		{Name: paramFlagInit, CfgKey: configuration.UnleashFlagInitKey, DefaultV: false, Usage: "flag the mutants found in the init functions, which can make the package fail to load"},
+		{Name: paramExportedOnly, CfgKey: configuration.UnleashExportedOnlyKey, DefaultV: false, Usage: "only mutate the code in the exported functions and methods"},
+		{Name: paramDedupePerPosition, CfgKey: configuration.UnleashDedupePerPositionKey, DefaultV: false, Usage: "keep only one mutant on each position, the one of the type with the highest priority"},
+		{Name: paramDedupePriority, CfgKey: configuration.UnleashDedupePriorityKey, DefaultV: []string{}, Usage: "a mutant type kept by dedupe-per-position before the others, in the given order, ex. conditionals-negation"},
 		{Name: paramOutput, CfgKey: configuration.UnleashOutputKey, Shorthand: "o", DefaultV: "", Usage: "set the output file for machine readable results"},
+		{Name: paramOutputFormat, CfgKey: configuration.UnleashOutputFormatKey, DefaultV: report.OutputFormatJSON, Usage: "the format of the output file, allowed values - 'json', 'sqlite', 'codequality'"},
+		{Name: paramGroupBy, CfgKey: configuration.UnleashGroupByKey, DefaultV: "", Usage: "print the mutants collapsed by group instead of one per line, allowed values - 'type'"},
+		{Name: paramSortBy, CfgKey: configuration.UnleashSortByKey, DefaultV: "", Usage: "sort the files of the results, allowed values - 'suspicion'"},
+		{Name: paramHotspots, CfgKey: configuration.UnleashHotspotsKey, DefaultV: 0, Usage: "report the files with the most NOT COVERED mutants, up to this number"},
+		{Name: paramPostHook, CfgKey: configuration.UnleashPostHookKey, DefaultV: "", Usage: "a command to run after the report, receiving the path of the output file"},
+		{Name: paramFailOnPostHook, CfgKey: configuration.UnleashFailOnPostHookKey, DefaultV: false, Usage: "fail if the post-hook command fails"},
+		{Name: paramWebhookURL, CfgKey: configuration.UnleashWebhookURLKey, DefaultV: "", Usage: "a URL to POST the JSON summary of the run to"},
+		{Name: paramJSONStdout, CfgKey: configuration.UnleashJSONStdoutKey, DefaultV: false, Usage: "print the machine readable results on stdout instead of the human readable ones"},
+		{Name: paramTAPStdout, CfgKey: configuration.UnleashTAPStdoutKey, DefaultV: false, Usage: "print the mutants on stdout in the Test Anything Protocol instead of the human readable results"},
+		{Name: paramLivedDiff, CfgKey: configuration.UnleashLivedDiffKey, DefaultV: false, Usage: "report the diff of the source change made by the LIVED mutants"},
+		{Name: paramLogFunction, CfgKey: configuration.UnleashLogFunctionKey, DefaultV: false, Usage: "print the function enclosing each mutant in the log"},
+		{Name: paramModuleRootPaths, CfgKey: configuration.UnleashModuleRootPathsKey, DefaultV: false, Usage: "report the file paths relative to the module root instead of the calling dir"},
+		{Name: paramImportPaths, CfgKey: configuration.UnleashImportPathsKey, DefaultV: false, Usage: "report the file paths prefixed by the import path of their package"},
 		{Name: paramIntegrationMode, CfgKey: configuration.UnleashIntegrationMode, Shorthand: "i", DefaultV: false, Usage: "makes Gremlins run the complete test suite for each mutation"},
+		{Name: paramSkipBuildCheck, CfgKey: configuration.UnleashSkipBuildCheckKey, DefaultV: false, Usage: "skip the build of the module before the mutation testing"},
+		{Name: paramGreenBaseline, CfgKey: configuration.UnleashGreenBaselineKey, DefaultV: false, Usage: "run the tests without mutations first, and abort if they fail"},
+		{Name: paramIsolateGoCache, CfgKey: configuration.UnleashIsolateGoCacheKey, DefaultV: false, Usage: "give each worker its own go build cache"},
+		{Name: paramBuildCacheDir, CfgKey: configuration.UnleashBuildCacheDirKey, DefaultV: "", Usage: "the go build cache directory shared by the coverage and all the workers"},
+		{Name: paramPprofCPU, CfgKey: configuration.UnleashPprofCPUKey, DefaultV: "", Usage: "write a CPU profile of gremlins itself to this file"},
+		{Name: paramPprofMem, CfgKey: configuration.UnleashPprofMemKey, DefaultV: "", Usage: "write a memory profile of gremlins itself to this file"},
 		{Name: paramExcludeFiles, CfgKey: configuration.UnleashExcludeFiles, Shorthand: "E", DefaultV: []string{}, Usage: "exclude files from Gremlins run by filepath regexp"},
+		{Name: paramGeneratedDirs, CfgKey: configuration.UnleashGeneratedDirsKey, DefaultV: []string{}, Usage: "a directory of generated code, relative to the module root, which is not mutated"},
+		{Name: paramExcludeLineRegex, CfgKey: configuration.UnleashExcludeLineRegexKey, DefaultV: "", Usage: "do not mutate the source lines matching this regexp, ex. 'log\\.'"},
+		{Name: paramSuppress, CfgKey: configuration.UnleashSuppressKey, DefaultV: []string{}, Usage: "report as SKIPPED the mutant with this 'file:line:column:TYPE' fingerprint"},
+		{Name: paramWarnExcluded, CfgKey: configuration.UnleashWarnExcludedKey, DefaultV: false, Usage: "warn if the excluded files contain mutants"},
+		{Name: paramFailOnExcluded, CfgKey: configuration.UnleashFailOnExcludedKey, DefaultV: false, Usage: "fail if the excluded files contain mutants"},
 		{Name: paramThresholdEfficacy, CfgKey: configuration.UnleashThresholdEfficacyKey, DefaultV: float64(0), Usage: "threshold for code-efficacy percent"},
 		{Name: paramThresholdMCoverage, CfgKey: configuration.UnleashThresholdMCoverageKey, DefaultV: float64(0), Usage: "threshold for mutant-coverage percent"},
+		{Name: paramStrict, CfgKey: configuration.UnleashStrictKey, DefaultV: false, Usage: "fail if the NOT VIABLE percent is above the not-viable threshold"},
+		{Name: paramThresholdNotViable, CfgKey: configuration.UnleashThresholdNotViableKey, DefaultV: float64(0), Usage: "threshold for not-viable percent in strict mode"},
+		{Name: paramFailOnNoCoverage, CfgKey: configuration.UnleashFailOnNoCoverageKey, DefaultV: false, Usage: "fail if the module has no test coverage at all"},
+		{Name: paramFailFast, CfgKey: configuration.UnleashFailFastKey, DefaultV: false, Usage: "stop the run and fail at the first LIVED mutant"},
+		{Name: paramConfirmKills, CfgKey: configuration.UnleashConfirmKillsKey, DefaultV: false, Usage: "run the tests of the KILLED mutants again without the mutation, and flag the kill as suspect if they fail"},
+		{Name: paramNotViableRetries, CfgKey: configuration.UnleashNotViableRetriesKey, DefaultV: 0, Usage: "the number of times a NOT VIABLE mutant is built and tested again, against transient build failures"},
+		{Name: paramTotalTestBudget, CfgKey: configuration.UnleashTotalTestBudgetKey, DefaultV: "", Usage: "stop dispatching the mutants once their tests took this total time, ex. 1h"},
+		{Name: paramMaxDuration, CfgKey: configuration.UnleashMaxDurationKey, DefaultV: "", Usage: "stop the run and report the partial results after this duration, ex. 30m"},
 		{Name: paramWorkers, CfgKey: configuration.UnleashWorkersKey, DefaultV: 0, Usage: "the number of workers to use in mutation testing"},
+		{Name: paramMaxFileWrites, CfgKey: configuration.UnleashMaxFileWritesKey, DefaultV: 0, Usage: "the maximum number of mutated files written at the same time, 0 means no limit"},
+		{Name: paramMaxMutantsPerFile, CfgKey: configuration.UnleashMaxMutantsPerFileKey, DefaultV: 0, Usage: "the maximum number of mutants of a file, the others are not reported, 0 means no limit"},
+		{Name: paramWriteRetries, CfgKey: configuration.UnleashWriteRetriesKey, DefaultV: 0, Usage: "the number of times a failed write of a mutated file is retried, with an increasing delay"},
+		{Name: paramFileMode, CfgKey: configuration.UnleashFileModeKey, DefaultV: "", Usage: "the octal permissions of the mutated files and of the workdir copies, ex. '0644'"},
+		{Name: paramSerializePkgs, CfgKey: configuration.UnleashSerializePkgsKey, DefaultV: false, Usage: "test the mutants of the same package one at a time, and the packages in parallel"},
 		{Name: paramTestCPU, CfgKey: configuration.UnleashTestCPUKey, DefaultV: 0, Usage: "the number of CPUs to allow each test run to use"},
+		{Name: paramTestJSON, CfgKey: configuration.UnleashTestJSONKey, DefaultV: false, Usage: "run go test with -json to tell build failures, test failures and timeouts apart"},
 		{Name: paramTimeoutCoefficient, CfgKey: configuration.UnleashTimeoutCoefficientKey, DefaultV: 0, Usage: "the coefficient by which the timeout is increased"},
 	}
 
@@ -233,10 +623,8 @@ func setFlagsOnCmd(cmd *cobra.Command) error {
 
 func setMutantTypeFlags(cmd *cobra.Command) error {
 	for _, mt := range mutator.Types {
-		name := mt.String()
-		usage := fmt.Sprintf("enable %q mutants", name)
-		param := strings.ReplaceAll(name, "_", "-")
-		param = strings.ToLower(param)
+		usage := fmt.Sprintf("enable %q mutants", mt)
+		param := mutantTypeParam(mt)
 		confKey := configuration.MutantTypeEnabledKey(mt)
 
 		err := flags.Set(cmd, &flags.Flag{
@@ -17,12 +17,20 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/exec"
 	"strings"
 	"testing"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/coverage"
+	"github.com/go-gremlins/gremlins/internal/execution"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+	"github.com/go-gremlins/gremlins/internal/log"
 	"github.com/go-gremlins/gremlins/internal/mutator"
 )
 
@@ -50,6 +58,11 @@ func TestUnleash(t *testing.T) {
 			flagType: "bool",
 			defValue: "true",
 		},
+		{
+			name:     "collection-element",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "conditionals-boundary",
 			flagType: "bool",
@@ -60,28 +73,188 @@ func TestUnleash(t *testing.T) {
 			flagType: "bool",
 			defValue: "true",
 		},
+		{
+			name:     "best-effort-coverage",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "build-cache-dir",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "changed-since",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "confirm-kills",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "context-replace",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "continue-to-return",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "coverpkg",
 			flagType: "string",
 			defValue: "",
 		},
+		{
+			name:     "cover-profile-file",
+			flagType: "stringArray",
+			defValue: "[]",
+		},
+		{
+			name:     "coverage-cache",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "dedupe-per-position",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "dedupe-priority",
+			flagType: "stringArray",
+			defValue: "[]",
+		},
 		{
 			name:      "diff",
 			shorthand: "D",
 			flagType:  "string",
 			defValue:  "",
 		},
+		{
+			name:     "diff-functions",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "drop-append-arg",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "drop-logical-operand",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "drop-struct-field",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:      "dry-run",
 			shorthand: "d",
 			flagType:  "bool",
 			defValue:  "false",
 		},
+		{
+			name:     "dump-coverage",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "dump-mutant",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "dump-mutant-output",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "exclude-line-regex",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "exported-only",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "fail-fast",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "fail-on-excluded",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "fail-on-post-hook",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "fail-on-no-coverage",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "file-mode",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "files-from",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "flag-init",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "float-sign-flip",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "generated-dirs",
+			flagType: "stringArray",
+			defValue: "[]",
+		},
+		{
+			name:     "group-by",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "hotspots",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "import-paths",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "increment-decrement",
 			flagType: "bool",
 			defValue: "true",
 		},
+		{
+			name:     "inject-early-return",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:      "integration",
 			shorthand: "i",
@@ -104,6 +277,11 @@ func TestUnleash(t *testing.T) {
 			defValue: "false",
 		},
 
+		{
+			name:     "invert-error-check",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "invert-logical",
 			flagType: "bool",
@@ -119,17 +297,207 @@ func TestUnleash(t *testing.T) {
 			flagType: "bool",
 			defValue: "true",
 		},
+		{
+			name:     "isolate-gocache",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "json-stdout",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "lived-diff",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "log-function",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "max-duration",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "max-file-writes",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "max-mutants-per-file",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "min-max-swap",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "module-root-paths",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "nil-check-invert",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "no-coverage",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "no-shared-ast",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "not-viable-retries",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "one-per-line",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "overlap-coverage",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:      "output",
 			shorthand: "o",
 			flagType:  "string",
 			defValue:  "",
 		},
+		{
+			name:     "output-format",
+			flagType: "string",
+			defValue: "json",
+		},
+		{
+			name:     "post-hook",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "pprof-cpu",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "pprof-mem",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "range-count-boundary",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "remove-default-case",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "remove-else",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "remove-go-stmt",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "remove-self-assignments",
 			flagType: "bool",
 			defValue: "false",
 		},
+		{
+			name:     "require-green-baseline",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "retry-lived",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "sample",
+			flagType: "float64",
+			defValue: "0",
+		},
+		{
+			name:     "sample-seed",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "serialize-packages",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "since-last-run",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "self-check",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "skip-build-check",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "slice-boundary",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "sort-by",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "strict",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "strictness-toggle",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "suppress",
+			flagType: "stringArray",
+			defValue: "[]",
+		},
+		{
+			name:     "switch-case-value",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "tag-matrix",
+			flagType: "string",
+			defValue: "",
+		},
 		{
 			name:      "tags",
 			shorthand: "t",
@@ -141,6 +509,16 @@ func TestUnleash(t *testing.T) {
 			flagType: "int",
 			defValue: "0",
 		},
+		{
+			name:     "test-json",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "tap-stdout",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "threshold-efficacy",
 			flagType: "float64",
@@ -151,11 +529,36 @@ func TestUnleash(t *testing.T) {
 			flagType: "float64",
 			defValue: "0",
 		},
+		{
+			name:     "threshold-not-viable",
+			flagType: "float64",
+			defValue: "0",
+		},
 		{
 			name:     "timeout-coefficient",
 			flagType: "int",
 			defValue: "0",
 		},
+		{
+			name:     "total-test-budget",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "warn-excluded",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "webhook-url",
+			flagType: "string",
+			defValue: "",
+		},
+		{
+			name:     "write-retries",
+			flagType: "int",
+			defValue: "0",
+		},
 		{
 			name:     "workers",
 			flagType: "int",
@@ -201,3 +604,121 @@ func TestUnleash(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckCoverage(t *testing.T) {
+	testCases := []struct {
+		name        string
+		profile     coverage.Profile
+		failOnNoCov bool
+		wantLog     string
+		wantErr     bool
+	}{
+		{
+			name:    "it doesn't warn if there is coverage",
+			profile: coverage.Profile{"file.go": {{StartLine: 1, EndLine: 2}}},
+		},
+		{
+			name:    "it warns if the coverage is empty",
+			profile: coverage.Profile{},
+			wantLog: "WARNING: no test coverage detected; all mutants will be NOT COVERED\n",
+		},
+		{
+			name:        "it fails if the coverage is empty and fail-on-no-coverage is set",
+			profile:     coverage.Profile{},
+			failOnNoCov: true,
+			wantLog:     "WARNING: no test coverage detected; all mutants will be NOT COVERED\n",
+			wantErr:     true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, eOut)
+			defer log.Reset()
+			configuration.Set(configuration.UnleashFailOnNoCoverageKey, tc.failOnNoCov)
+			defer configuration.Reset()
+
+			err := checkCoverage(tc.profile)
+
+			if got := eOut.String(); got != tc.wantLog {
+				t.Errorf("want log %q, got %q", tc.wantLog, got)
+			}
+			if !tc.wantErr {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+			var exitErr *execution.ExitError
+			if !errors.As(err, &exitErr) || exitErr.ExitCode() != 13 {
+				t.Errorf("expected exit error with code 13, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBuildCheck(t *testing.T) {
+	mod := gomodule.GoModule{Name: "example.com", Root: "."}
+	testCases := []struct {
+		name      string
+		cmd       execContext
+		skipCheck bool
+		wantErr   bool
+	}{
+		{
+			name: "it doesn't fail if the module builds",
+			cmd:  fakeExecCommand("TestBuildProcessSuccess"),
+		},
+		{
+			name:    "it aborts if the module doesn't build",
+			cmd:     fakeExecCommand("TestBuildProcessFailure"),
+			wantErr: true,
+		},
+		{
+			name:      "it doesn't build if skip-build-check is set",
+			cmd:       fakeExecCommand("TestBuildProcessFailure"),
+			skipCheck: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashSkipBuildCheckKey, tc.skipCheck)
+			defer configuration.Reset()
+
+			err := buildCheck(tc.cmd, mod)
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("buildCheck() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildProcessSuccess(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	os.Exit(0) // skipcq: RVV-A0003
+}
+
+func TestBuildProcessFailure(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
+func fakeExecCommand(process string) execContext {
+	return func(command string, args ...string) *exec.Cmd {
+		cs := []string{"-test.run=" + process, "--", command}
+		cs = append(cs, args...)
+		// #nosec G204 - We are in tests, we don't care
+		cmd := exec.Command(os.Args[0], cs...)
+		cmd.Env = []string{"GO_TEST_PROCESS=1"}
+
+		return cmd
+	}
+}
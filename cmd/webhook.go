@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+// webhookClient is the client used to POST the summary to the webhook.
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// notifyWebhook POSTs the JSON summary of the results to the webhook-url,
+// if set, for example a Slack incoming webhook proxy or a dashboard.
+//
+// The notification is best-effort: a failure is only logged and never
+// fails the run.
+func notifyWebhook(client *http.Client, results report.Results) {
+	url := configuration.Get[string](configuration.UnleashWebhookURLKey)
+	if url == "" {
+		return
+	}
+	summary, ok := report.Summarize(results)
+	if !ok {
+		return
+	}
+	if err := postSummary(client, url, summary); err != nil {
+		log.Errorf("the webhook notification failed: %s\n", err)
+	}
+}
+
+func postSummary(client *http.Client, url string, summary report.Summary) error {
+	body, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Draining the body lets the client reuse the connection.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
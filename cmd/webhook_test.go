@@ -0,0 +1,129 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report"
+)
+
+type webhookMutant struct {
+	matrixMutant
+}
+
+func (webhookMutant) InInit() bool {
+	return false
+}
+
+func (webhookMutant) SuspectKill() bool {
+	return false
+}
+
+func (webhookMutant) Function() string {
+	return ""
+}
+
+func (webhookMutant) Duration() time.Duration {
+	return 0
+}
+
+var webhookResults = report.Results{
+	Module: "example.com",
+	Mutants: []mutator.Mutator{
+		webhookMutant{matrixMutant{status: mutator.Killed, mType: mutator.ArithmeticBase, line: 1}},
+		webhookMutant{matrixMutant{status: mutator.Killed, mType: mutator.ArithmeticBase, line: 2}},
+		webhookMutant{matrixMutant{status: mutator.Lived, mType: mutator.ArithmeticBase, line: 3}},
+		webhookMutant{matrixMutant{status: mutator.NotCovered, mType: mutator.ArithmeticBase, line: 4}},
+	},
+	Elapsed: time.Second,
+}
+
+func TestNotifyWebhook(t *testing.T) {
+	var contentType string
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Errorf("expected a JSON body, got %q", body)
+		}
+	}))
+	defer server.Close()
+	log.Init(&bytes.Buffer{}, &bytes.Buffer{})
+	defer log.Reset()
+	configuration.Set(configuration.UnleashWebhookURLKey, server.URL)
+	defer configuration.Reset()
+
+	notifyWebhook(server.Client(), webhookResults)
+
+	if contentType != "application/json" {
+		t.Errorf("expected content type application/json, got %q", contentType)
+	}
+	want := map[string]any{
+		"go_module":          "example.com",
+		"test_efficacy":      float64(2) / 3 * 100,
+		"mutations_coverage": float64(75),
+		"mutants_lived":      float64(1),
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestNotifyWebhookFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	eOut := &bytes.Buffer{}
+	log.Init(&bytes.Buffer{}, eOut)
+	defer log.Reset()
+	configuration.Set(configuration.UnleashWebhookURLKey, server.URL)
+	defer configuration.Reset()
+
+	notifyWebhook(server.Client(), webhookResults)
+
+	if eOut.Len() == 0 {
+		t.Error("expected the failure to be logged")
+	}
+}
+
+func TestNotifyWebhookNotSet(t *testing.T) {
+	invoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		invoked = true
+	}))
+	defer server.Close()
+
+	notifyWebhook(server.Client(), webhookResults)
+
+	if invoked {
+		t.Error("expected the webhook not to be invoked")
+	}
+}
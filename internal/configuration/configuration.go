@@ -36,16 +36,77 @@ const (
 	UnleashDryRunKey             = "unleash.dry-run"
 	UnleashOutputStatusesKey     = "unleash.output-statuses"
 	UnleashOutputKey             = "unleash.output"
+	UnleashGroupByKey            = "unleash.group-by"
+	UnleashSortByKey             = "unleash.sort-by"
+	UnleashJSONStdoutKey         = "unleash.json-stdout"
+	UnleashTAPStdoutKey          = "unleash.tap-stdout"
+	UnleashPostHookKey           = "unleash.post-hook"
+	UnleashFailOnPostHookKey     = "unleash.fail-on-post-hook"
+	UnleashWebhookURLKey         = "unleash.webhook-url"
+	UnleashOutputFormatKey       = "unleash.output-format"
+	UnleashHotspotsKey           = "unleash.hotspots"
+	UnleashLivedDiffKey          = "unleash.lived-diff"
+	UnleashLogFunctionKey        = "unleash.log-function"
+	UnleashModuleRootPathsKey    = "unleash.module-root-paths"
+	UnleashImportPathsKey        = "unleash.import-paths"
 	UnleashTagsKey               = "unleash.tags"
+	UnleashTagMatrixKey          = "unleash.tag-matrix"
 	UnleashCoverPkgKey           = "unleash.coverpkg"
+	UnleashCoverProfileFilesKey  = "unleash.cover-profile-file"
+	UnleashNoCoverageKey         = "unleash.no-coverage"
+	UnleashNoSharedASTKey        = "unleash.no-shared-ast"
+	UnleashSelfCheckKey          = "unleash.self-check"
+	UnleashOverlapCovKey         = "unleash.overlap-coverage"
+	UnleashDumpCoverageKey       = "unleash.dump-coverage"
+	UnleashDumpMutantKey         = "unleash.dump-mutant"
+	UnleashDumpMutantOutKey      = "unleash.dump-mutant-output"
 	UnleashWorkersKey            = "unleash.workers"
+	UnleashMaxFileWritesKey      = "unleash.max-file-writes"
+	UnleashMaxMutantsPerFileKey  = "unleash.max-mutants-per-file"
+	UnleashWriteRetriesKey       = "unleash.write-retries"
+	UnleashFileModeKey           = "unleash.file-mode"
+	UnleashSerializePkgsKey      = "unleash.serialize-packages"
 	UnleashTestCPUKey            = "unleash.test-cpu"
+	UnleashTestJSONKey           = "unleash.test-json"
 	UnleashTimeoutCoefficientKey = "unleash.timeout-coefficient"
 	UnleashIntegrationMode       = "unleash.integration"
+	UnleashSkipBuildCheckKey     = "unleash.skip-build-check"
+	UnleashGreenBaselineKey      = "unleash.require-green-baseline"
+	UnleashIsolateGoCacheKey     = "unleash.isolate-gocache"
+	UnleashBuildCacheDirKey      = "unleash.build-cache-dir"
+	UnleashBestEffortCoverageKey = "unleash.best-effort-coverage"
+	UnleashCoverageCacheKey      = "unleash.coverage-cache"
+	UnleashPprofCPUKey           = "unleash.pprof-cpu"
+	UnleashPprofMemKey           = "unleash.pprof-mem"
 	UnleashExcludeFiles          = "unleash.exclude-files"
+	UnleashGeneratedDirsKey      = "unleash.generated-dirs"
+	UnleashExcludeLineRegexKey   = "unleash.exclude-line-regex"
+	UnleashSuppressKey           = "unleash.suppress"
 	UnleashDiffRef               = "unleash.diff"
+	UnleashDiffFunctionsKey      = "unleash.diff-functions"
+	UnleashChangedSinceKey       = "unleash.changed-since"
+	UnleashFilesFromKey          = "unleash.files-from"
+	UnleashSinceLastRunKey       = "unleash.since-last-run"
+	UnleashRetryLivedKey         = "unleash.retry-lived"
+	UnleashSampleKey             = "unleash.sample"
+	UnleashSampleSeedKey         = "unleash.sample-seed"
+	UnleashOnePerLineKey         = "unleash.one-per-line"
+	UnleashFlagInitKey           = "unleash.flag-init"
+	UnleashExportedOnlyKey       = "unleash.exported-only"
+	UnleashDedupePerPositionKey  = "unleash.dedupe-per-position"
+	UnleashDedupePriorityKey     = "unleash.dedupe-priority"
+	UnleashStrictKey             = "unleash.strict"
+	UnleashFailOnNoCoverageKey   = "unleash.fail-on-no-coverage"
+	UnleashFailFastKey           = "unleash.fail-fast"
+	UnleashConfirmKillsKey       = "unleash.confirm-kills"
+	UnleashNotViableRetriesKey   = "unleash.not-viable-retries"
+	UnleashMaxDurationKey        = "unleash.max-duration"
+	UnleashTotalTestBudgetKey    = "unleash.total-test-budget"
+	UnleashWarnExcludedKey       = "unleash.warn-excluded"
+	UnleashFailOnExcludedKey     = "unleash.fail-on-excluded"
 	UnleashThresholdEfficacyKey  = "unleash.threshold.efficacy"
 	UnleashThresholdMCoverageKey = "unleash.threshold.mutant-coverage"
+	UnleashThresholdNotViableKey = "unleash.threshold.not-viable"
 )
 
 const (
@@ -65,7 +126,10 @@ const (
 // format:
 //
 //	GREMLINS_<COMMAND NAME>_<FLAG NAME>
-func Init(cPaths []string) error {
+//
+// When noProjectConfig is set, the default paths don't include the Go module root and the current
+// directory, so the configuration file shipped with the project is ignored.
+func Init(cPaths []string, noProjectConfig bool) error {
 	replacer := strings.NewReplacer(".", "_", "-", "_")
 	viper.SetEnvKeyReplacer(replacer)
 	viper.SetEnvPrefix(gremlinsEnvVarPrefix)
@@ -80,7 +144,7 @@ func Init(cPaths []string) error {
 			return err
 		}
 	} else if arePathsNotSet(cPaths) {
-		cPaths = defaultConfigPaths()
+		cPaths = defaultConfigPaths(!noProjectConfig)
 	}
 
 	for _, p := range cPaths {
@@ -100,11 +164,36 @@ func Init(cPaths []string) error {
 //	 		mutant-name:
 //	 			enabled: [bool]
 func MutantTypeEnabledKey(mt mutator.Type) string {
+	return fmt.Sprintf("mutants.%s.enabled", mutantName(mt))
+}
+
+// MutantTypeSeverityKey returns the configuration key for the severity of a
+// mutant. The generated key will have the format 'mutants.mutant-name.severity",
+// which corresponds to the Yaml:
+//
+//		mutants:
+//	 		mutant-name:
+//	 			severity: [high|medium|low]
+func MutantTypeSeverityKey(mt mutator.Type) string {
+	return fmt.Sprintf("mutants.%s.severity", mutantName(mt))
+}
+
+// MutantTypeTokensKey returns the configuration key for the tokens a mutant
+// of a token type is restricted to. The generated key will have the format
+// 'mutants.mutant-name.tokens", which corresponds to the Yaml:
+//
+//		mutants:
+//	 		mutant-name:
+//	 			tokens: ["+=", "-="]
+func MutantTypeTokensKey(mt mutator.Type) string {
+	return fmt.Sprintf("mutants.%s.tokens", mutantName(mt))
+}
+
+func mutantName(mt mutator.Type) string {
 	m := mt.String()
 	m = strings.ReplaceAll(m, "_", "-")
-	m = strings.ToLower(m)
 
-	return fmt.Sprintf("mutants.%s.enabled", m)
+	return strings.ToLower(m)
 }
 
 func isSpecificFile(cPaths []string) bool {
@@ -115,7 +204,7 @@ func arePathsNotSet(cPaths []string) bool {
 	return len(cPaths) == 0 || len(cPaths) == 1 && cPaths[0] == ""
 }
 
-func defaultConfigPaths() []string {
+func defaultConfigPaths(project bool) []string {
 	result := make([]string, 0, 4)
 
 	// First global config
@@ -138,6 +227,10 @@ func defaultConfigPaths() []string {
 	}
 	result = append(result, homeLocation)
 
+	if !project {
+		return result
+	}
+
 	// Then the Go module root
 	if root := findModuleRoot(); root != "" {
 		result = append(result, root)
@@ -178,15 +271,40 @@ func Set[T any](k string, v T) {
 }
 
 // Get offers synchronised access to Viper.
+// The values of the environment variables are strings, so they are
+// converted to T, as the ones of the flags and the configuration file
+// already are.
 func Get[T any](k string) T {
-	var r T
 	mutex.RLock()
 	defer mutex.RUnlock()
-	r, _ = viper.Get(k).(T)
+	v := viper.Get(k)
+	r, ok := v.(T)
+	if _, isString := v.(string); ok || !isString {
+		return r
+	}
+	switch any(r).(type) {
+	case bool:
+		r, _ = any(viper.GetBool(k)).(T)
+	case int:
+		r, _ = any(viper.GetInt(k)).(T)
+	case float64:
+		r, _ = any(viper.GetFloat64(k)).(T)
+	case []string:
+		r, _ = any(viper.GetStringSlice(k)).(T)
+	}
 
 	return r
 }
 
+// GetStrings offers synchronised access to a list of strings of Viper,
+// whether it comes from a flag or from the configuration file.
+func GetStrings(k string) []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return viper.GetStringSlice(k)
+}
+
 // Reset is used mainly for testing purposes, in order to clean up the Viper
 // instance.
 func Reset() {
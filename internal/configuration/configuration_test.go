@@ -107,7 +107,7 @@ func TestConfiguration(t *testing.T) {
 					t.Setenv(e.name, e.value)
 				}
 			}
-			err := Init(tc.configPaths)
+			err := Init(tc.configPaths, false)
 			if tc.expectErr && err == nil {
 				t.Fatal("expected error")
 			}
@@ -126,6 +126,71 @@ func TestConfiguration(t *testing.T) {
 	}
 }
 
+func TestMutantTypeEnabledFromEnv(t *testing.T) {
+	testCases := []struct {
+		name       string
+		env        string
+		value      string
+		mutantType mutator.Type
+		want       bool
+	}{
+		{
+			name:       "it disables a mutant type enabled by default",
+			env:        "GREMLINS_MUTANTS_CONDITIONALS_BOUNDARY_ENABLED",
+			value:      "false",
+			mutantType: mutator.ConditionalsBoundary,
+			want:       false,
+		},
+		{
+			name:       "it enables a mutant type disabled by default",
+			env:        "GREMLINS_MUTANTS_INVERT_LOOPCTRL_ENABLED",
+			value:      "true",
+			mutantType: mutator.InvertLoopCtrl,
+			want:       true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(tc.env, tc.value)
+			if err := Init(nil, true); err != nil {
+				t.Fatal(err)
+			}
+			defer viper.Reset()
+			// As the flags of the mutant types do.
+			key := MutantTypeEnabledKey(tc.mutantType)
+			viper.SetDefault(key, IsDefaultEnabled(tc.mutantType))
+
+			if got := Get[bool](key); got != tc.want {
+				t.Errorf("expected %s to be %v, got %v", key, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetConvertsEnv(t *testing.T) {
+	t.Setenv("GREMLINS_UNLEASH_DRY_RUN", "true")
+	t.Setenv("GREMLINS_UNLEASH_WORKERS", "3")
+	t.Setenv("GREMLINS_UNLEASH_THRESHOLD_EFFICACY", "80.5")
+	if err := Init(nil, true); err != nil {
+		t.Fatal(err)
+	}
+	defer viper.Reset()
+
+	if got := Get[bool](UnleashDryRunKey); !got {
+		t.Errorf("expected dry-run to be true, got %v", got)
+	}
+	if got := Get[int](UnleashWorkersKey); got != 3 {
+		t.Errorf("expected workers to be 3, got %d", got)
+	}
+	if got := Get[float64](UnleashThresholdEfficacyKey); got != 80.5 {
+		t.Errorf("expected threshold efficacy to be 80.5, got %v", got)
+	}
+	if got := Get[string](UnleashTagsKey); got != "" {
+		t.Errorf("expected no tags, got %q", got)
+	}
+}
+
 func TestConfigPaths(t *testing.T) {
 	home, _ := homedir.Dir()
 
@@ -156,7 +221,7 @@ func TestConfigPaths(t *testing.T) {
 		// Last current folder
 		want = append(want, ".")
 
-		got := defaultConfigPaths()
+		got := defaultConfigPaths(true)
 
 		if !cmp.Equal(got, want) {
 			t.Errorf(cmp.Diff(got, want))
@@ -186,7 +251,34 @@ func TestConfigPaths(t *testing.T) {
 		// Last current folder
 		want = append(want, ".")
 
-		got := defaultConfigPaths()
+		got := defaultConfigPaths(true)
+
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(got, want))
+		}
+	})
+
+	t.Run("without the project config, it doesn't lookup in module root and current folder", func(t *testing.T) {
+		oldDir, _ := os.Getwd()
+		_ = os.Chdir("testdata/config1")
+		defer func(dir string) {
+			_ = os.Chdir(dir)
+		}(oldDir)
+
+		var want []string
+
+		// First global
+		if runtime.GOOS != windowsOs {
+			want = append(want, "/etc/gremlins")
+		}
+
+		// Then $XDG_CONFIG_HOME and $HOME
+		want = append(want,
+			filepath.Join(home, ".config", "gremlins", "gremlins"),
+			filepath.Join(home, ".gremlins"),
+		)
+
+		got := defaultConfigPaths(false)
 
 		if !cmp.Equal(got, want) {
 			t.Errorf(cmp.Diff(got, want))
@@ -222,7 +314,7 @@ func TestConfigPaths(t *testing.T) {
 		// Last the current directory
 		want = append(want, ".")
 
-		got := defaultConfigPaths()
+		got := defaultConfigPaths(true)
 
 		if !cmp.Equal(got, want) {
 			t.Errorf(cmp.Diff(got, want))
@@ -230,6 +322,54 @@ func TestConfigPaths(t *testing.T) {
 	})
 }
 
+func TestProjectConfig(t *testing.T) {
+	homedir.DisableCache = true
+	defer func() { homedir.DisableCache = false }()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(xdgConfigHomeKey, t.TempDir())
+
+	root := t.TempDir()
+	_ = os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com\n"), 0600)
+	_ = os.WriteFile(filepath.Join(root, ".gremlins.yaml"), []byte("unleash:\n  tags: project\n"), 0600)
+	pkg := filepath.Join(root, "pkg")
+	_ = os.Mkdir(pkg, 0700)
+
+	oldDir, _ := os.Getwd()
+	_ = os.Chdir(pkg)
+	defer func(dir string) {
+		_ = os.Chdir(dir)
+	}(oldDir)
+
+	testCases := []struct {
+		name            string
+		want            string
+		noProjectConfig bool
+	}{
+		{
+			name: "it reads the config file of the module root",
+			want: "project",
+		},
+		{
+			name:            "it ignores the config file of the module root with no-project-config",
+			noProjectConfig: true,
+			want:            "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			defer viper.Reset()
+			if err := Init(nil, tc.noProjectConfig); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := Get[string](UnleashTagsKey); got != tc.want {
+				t.Errorf("expected tags to be %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestGeneratesMutantTypeEnabledKey(t *testing.T) {
 	mt := mutator.ArithmeticBase
 	want := "mutants.arithmetic-base.enabled"
@@ -241,6 +381,28 @@ func TestGeneratesMutantTypeEnabledKey(t *testing.T) {
 	}
 }
 
+func TestGeneratesMutantTypeSeverityKey(t *testing.T) {
+	mt := mutator.InvertLoopCtrl
+	want := "mutants.invert-loopctrl.severity"
+
+	got := MutantTypeSeverityKey(mt)
+
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestGeneratesMutantTypeTokensKey(t *testing.T) {
+	mt := mutator.RemoveSelfAssignments
+	want := "mutants.remove-self-assignments.tokens"
+
+	got := MutantTypeTokensKey(mt)
+
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
 func TestViperSynchronisedAccess(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
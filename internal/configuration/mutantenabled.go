@@ -32,11 +32,36 @@ var mutationEnabled = map[mutator.Type]bool{
 	mutator.InvertLoopCtrl:           false,
 	mutator.InvertNegatives:          true,
 	mutator.RemoveSelfAssignments:    false,
+	mutator.DropAppendArg:            false,
+	mutator.InvertErrorCheck:         false,
+	mutator.NilCheckInvert:           false,
+	mutator.MinMaxSwap:               false,
+	mutator.DropStructField:          false,
+	mutator.ContinueToReturn:         false,
+	mutator.RangeCountBoundary:       false,
+	mutator.DropLogicalOperand:       false,
+	mutator.SliceBoundary:            false,
+	mutator.SwitchCaseValue:          false,
+	mutator.InjectEarlyReturn:        false,
+	mutator.FloatSignFlip:            false,
+	mutator.ContextReplace:           false,
+	mutator.RemoveGoStmt:             false,
+	mutator.RemoveElse:               false,
+	mutator.CollectionElement:        false,
+	mutator.StrictnessToggle:         false,
+	mutator.RemoveDefaultCase:        false,
 }
 
 // IsDefaultEnabled returns the default enabled/disabled state of the mutation.
 // It gets the state from the table above that must be kept up to date when adding
 // new mutant types.
+// Custom mutant types are not in the table and are enabled by default, since
+// registering them is already an explicit choice.
 func IsDefaultEnabled(mt mutator.Type) bool {
-	return mutationEnabled[mt]
+	enabled, ok := mutationEnabled[mt]
+	if !ok {
+		return true
+	}
+
+	return enabled
 }
@@ -74,6 +74,78 @@ func TestMutantDefaultStatus(t *testing.T) {
 			mutantType: mutator.RemoveSelfAssignments,
 			expected:   false,
 		},
+		{
+			mutantType: mutator.DropAppendArg,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.InvertErrorCheck,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.NilCheckInvert,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.MinMaxSwap,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.DropStructField,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ContinueToReturn,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RangeCountBoundary,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.DropLogicalOperand,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.SliceBoundary,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.SwitchCaseValue,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.InjectEarlyReturn,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.FloatSignFlip,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ContextReplace,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveGoStmt,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveElse,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.CollectionElement,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.StrictnessToggle,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveDefaultCase,
+			expected:   false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -51,7 +51,11 @@ type Coverage struct {
 
 	buildTags       string
 	coverPkg        string
+	profileFiles    []string
 	integrationMode bool
+	buildCacheDir   string
+	bestEffort      bool
+	useCache        bool
 }
 
 // Option for the Coverage initialization.
@@ -70,6 +74,10 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 	buildTags := configuration.Get[string](configuration.UnleashTagsKey)
 	coverPkg := configuration.Get[string](configuration.UnleashCoverPkgKey)
 	integrationMode := configuration.Get[bool](configuration.UnleashIntegrationMode)
+	profileFiles := absPaths(configuration.Get[[]string](configuration.UnleashCoverProfileFilesKey))
+	buildCacheDir := configuration.Get[string](configuration.UnleashBuildCacheDirKey)
+	bestEffort := configuration.Get[bool](configuration.UnleashBestEffortCoverageKey)
+	useCache := configuration.Get[bool](configuration.UnleashCoverageCacheKey)
 
 	c := &Coverage{
 		cmdContext:      cmdContext,
@@ -79,7 +87,11 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 		mod:             mod,
 		buildTags:       buildTags,
 		coverPkg:        coverPkg,
+		profileFiles:    profileFiles,
 		integrationMode: integrationMode,
+		buildCacheDir:   buildCacheDir,
+		bestEffort:      bestEffort,
+		useCache:        useCache,
 	}
 	for _, opt := range opts {
 		c = opt(c)
@@ -88,8 +100,25 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 	return c
 }
 
+// absPaths makes the paths absolute, since Run changes the current directory.
+func absPaths(paths []string) []string {
+	abs := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if a, err := filepath.Abs(p); err == nil {
+			p = a
+		}
+		abs = append(abs, p)
+	}
+
+	return abs
+}
+
 // Run executes the coverage command and parses the results, returning a *Profile
 // object.
+// The additional coverage profile files, if any, are merged in the resulting
+// Profile.
+// With the best effort coverage, the packages failing to build or to pass
+// their tests are logged and the Profile of the other ones is returned.
 // Before executing the coverage, it downloads the go modules in a separate step.
 // This is done to avoid that the download phase impacts the execution time which
 // is later used as timeout for the mutant testing execution.
@@ -108,12 +137,23 @@ func (c *Coverage) Run() (Result, error) {
 	if err != nil {
 		return Result{}, fmt.Errorf("an error occurred while generating coverage profile: %w", err)
 	}
+	for _, pf := range c.profileFiles {
+		other, err := c.profileFromFile(pf)
+		if err != nil {
+			return Result{}, fmt.Errorf("an error occurred while reading coverage profile %q: %w", pf, err)
+		}
+		profile.Merge(other)
+	}
 
 	return Result{Profile: profile, Elapsed: elapsed}, nil
 }
 
 func (c *Coverage) profile() (Profile, error) {
-	cf, err := os.Open(c.filePath())
+	return c.profileFromFile(c.filePath())
+}
+
+func (c *Coverage) profileFromFile(path string) (Profile, error) {
+	cf, err := os.Open(path)
 	defer func(cf *os.File) {
 		_ = cf.Close()
 	}(cf)
@@ -142,6 +182,11 @@ func (c *Coverage) downloadModules() error {
 
 func (c *Coverage) executeCoverage() (time.Duration, error) {
 	args := []string{"test"}
+	// The elapsed time is the base of the timeout of the mutants, so the
+	// tests run again unless asked otherwise: a cached result takes no time.
+	if !c.useCache {
+		args = append(args, "-count=1")
+	}
 	if c.buildTags != "" {
 		args = append(args, "-tags", c.buildTags)
 	}
@@ -151,17 +196,41 @@ func (c *Coverage) executeCoverage() (time.Duration, error) {
 
 	args = append(args, "-cover", "-coverprofile", c.filePath(), c.scanPath())
 	cmd := c.cmdContext("go", args...)
+	if c.buildCacheDir != "" {
+		cmd.Env = append(cmd.Env, os.Environ()...)
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOCACHE=%s", c.buildCacheDir))
+	}
 
 	start := time.Now()
 	if out, err := cmd.CombinedOutput(); err != nil {
 		log.Infof("\n%s\n", string(out))
-
-		return 0, err
+		failed := failedPackages(string(out))
+		if !c.bestEffort || len(failed) == 0 {
+			return 0, err
+		}
+		log.Warnf("the coverage of %d packages failed, their mutants will be NOT COVERED: %s\n", len(failed), strings.Join(failed, ", "))
 	}
 
 	return time.Since(start), nil
 }
 
+// failedPackages returns the packages reported as failed in the output of
+// go test, ex. 'FAIL	example.com/pkg [build failed]'.
+func failedPackages(out string) []string {
+	var failed []string
+	for _, line := range strings.Split(out, "\n") {
+		if !strings.HasPrefix(line, "FAIL\t") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "FAIL\t"))
+		if len(fields) > 0 {
+			failed = append(failed, fields[0])
+		}
+	}
+
+	return failed
+}
+
 func (c *Coverage) scanPath() string {
 	path := "./..."
 	if !c.integrationMode {
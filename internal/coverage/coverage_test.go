@@ -17,7 +17,9 @@
 package coverage_test
 
 import (
+	"bytes"
 	"fmt"
+	"go/token"
 	"os"
 	"os/exec"
 	"strings"
@@ -27,6 +29,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/go-gremlins/gremlins/internal/coverage"
+	"github.com/go-gremlins/gremlins/internal/log"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
 	"github.com/go-gremlins/gremlins/internal/gomodule"
@@ -94,7 +97,7 @@ func TestCoverageRun(t *testing.T) {
 			_, _ = cov.Run()
 
 			firstWant := "go mod download"
-			secondWant := fmt.Sprintf("go test -tags tag1 tag2 -coverpkg %s -cover -coverprofile %v %s",
+			secondWant := fmt.Sprintf("go test -count=1 -tags tag1 tag2 -coverpkg %s -cover -coverprofile %v %s",
 				coverpkg, wantFilePath, tc.wantPath)
 
 			if len(holder.events) != 2 {
@@ -113,6 +116,72 @@ func TestCoverageRun(t *testing.T) {
 	}
 }
 
+func TestCoverageCache(t *testing.T) {
+	testCases := []struct {
+		name     string
+		useCache bool
+		want     string
+	}{
+		{
+			name: "it runs the tests again by default",
+			want: "go test -count=1 -cover -coverprofile workdir/coverage ./...",
+		},
+		{
+			name:     "it reuses the cached results when asked",
+			useCache: true,
+			want:     "go test -cover -coverprofile workdir/coverage ./...",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Set(configuration.UnleashCoverageCacheKey, tc.useCache)
+			defer viper.Reset()
+			holder := &commandHolder{}
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			cov := coverage.NewWithCmd(fakeExecCommandSuccess(holder), "workdir", mod)
+
+			_, _ = cov.Run()
+
+			if len(holder.events) != 2 {
+				t.Fatal("expected two commands to be executed")
+			}
+			got := fmt.Sprintf("go %v", strings.Join(holder.events[1].args, " "))
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+// The coverage is gathered without -bench, so that the code exercised only
+// by the Benchmark functions is NOT COVERED: the benchmarks don't verify its
+// correctness.
+func TestCoverageDoesNotRunBenchmarks(t *testing.T) {
+	holder := &commandHolder{}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	cov := coverage.NewWithCmd(fakeExecCommandSuccess(holder), "workdir", mod)
+
+	_, _ = cov.Run()
+
+	if len(holder.events) != 2 {
+		t.Fatal("expected two commands to be executed")
+	}
+	for _, arg := range holder.events[1].args {
+		if strings.HasPrefix(arg, "-bench") || strings.HasPrefix(arg, "-test.bench") {
+			t.Errorf("expected the coverage not to run the benchmarks, got %s", arg)
+		}
+	}
+}
+
 func TestCoverageRunFails(t *testing.T) {
 	mod := gomodule.GoModule{
 		Name:       "example.com",
@@ -134,6 +203,46 @@ func TestCoverageRunFails(t *testing.T) {
 	})
 }
 
+func TestCoverageBestEffort(t *testing.T) {
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		CallingDir: "path",
+	}
+
+	t.Run("returns the partial profile with a warning", func(t *testing.T) {
+		viper.Set(configuration.UnleashBestEffortCoverageKey, true)
+		defer viper.Reset()
+		eOut := &bytes.Buffer{}
+		log.Init(&bytes.Buffer{}, eOut)
+		defer log.Reset()
+		cov := coverage.NewWithCmd(fakeExecCommandPartialFailure(), "testdata/valid", mod)
+
+		got, err := cov.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := coverage.Profile{
+			"file1.go": {{StartLine: 47, StartCol: 2, EndLine: 48, EndCol: 16}},
+			"file2.go": {{StartLine: 52, StartCol: 2, EndLine: 53, EndCol: 16}},
+		}
+		if !cmp.Equal(got.Profile, want) {
+			t.Error(cmp.Diff(want, got.Profile))
+		}
+		if !strings.Contains(eOut.String(), "example.com/broken") {
+			t.Errorf("expected a warning about the failing package, got %q", eOut.String())
+		}
+	})
+
+	t.Run("fails without best effort", func(t *testing.T) {
+		cov := coverage.NewWithCmd(fakeExecCommandPartialFailure(), "testdata/valid", mod)
+
+		if _, err := cov.Run(); err == nil {
+			t.Error("expected run to report an error")
+		}
+	})
+}
+
 func TestCoverageParsesOutput(t *testing.T) {
 	module := "example.com"
 	mod := gomodule.GoModule{
@@ -176,6 +285,47 @@ func TestCoverageParsesOutput(t *testing.T) {
 	}
 }
 
+func TestCoverageMergesProfileFiles(t *testing.T) {
+	viper.Set(configuration.UnleashCoverProfileFilesKey, []string{"testdata/extra/coverage"})
+	defer viper.Reset()
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		CallingDir: "path",
+	}
+	cov := coverage.NewWithCmd(fakeExecCommandSuccess(nil), "testdata/valid", mod)
+
+	got, err := cov.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := coverage.Profile{
+		"file1.go": {{StartLine: 47, StartCol: 2, EndLine: 48, EndCol: 16}},
+		"file2.go": {{StartLine: 52, StartCol: 2, EndLine: 53, EndCol: 16}},
+		"file3.go": {{StartLine: 10, StartCol: 2, EndLine: 12, EndCol: 3}},
+	}
+	if !cmp.Equal(got.Profile, want) {
+		t.Error(cmp.Diff(want, got.Profile))
+	}
+	if !got.Profile.IsCovered(token.Position{Filename: "file3.go", Line: 11, Column: 5}) {
+		t.Errorf("expected position covered only by the additional profile to be covered")
+	}
+}
+
+func TestCoverageFailsOnMissingProfileFile(t *testing.T) {
+	viper.Set(configuration.UnleashCoverProfileFilesKey, []string{"testdata/missing/coverage"})
+	defer viper.Reset()
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		CallingDir: "path",
+	}
+	cov := coverage.NewWithCmd(fakeExecCommandSuccess(nil), "testdata/valid", mod)
+
+	if _, err := cov.Run(); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
 func TestParseOutputFail(t *testing.T) {
 	mod := gomodule.GoModule{
 		Name:       "example.com",
@@ -202,6 +352,16 @@ func TestCoverageProcessFailure(_ *testing.T) {
 	os.Exit(1) // skipcq: RVV-A0003
 }
 
+func TestCoverageProcessPartialFailure(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	fmt.Print("ok  \texample.com/path\t0.010s\n" +
+		"FAIL\texample.com/broken [build failed]\n" +
+		"FAIL\n")
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
 type execContext = func(name string, args ...string) *exec.Cmd
 
 func fakeExecCommandSuccess(got *commandHolder) execContext {
@@ -239,3 +399,21 @@ func fakeExecCommandFailure(run int) execContext {
 		return cmd
 	}
 }
+
+func fakeExecCommandPartialFailure() execContext {
+	var executed int
+
+	return func(command string, args ...string) *exec.Cmd {
+		cs := []string{"-test.run=TestCoverageProcessSuccess", "--", command}
+		if executed == 1 {
+			cs = []string{"-test.run=TestCoverageProcessPartialFailure", "--", command}
+		}
+		cs = append(cs, args...)
+		// #nosec G204 - We are in tests, we don't care
+		cmd := exec.Command(os.Args[0], cs...)
+		cmd.Env = []string{"GO_TEST_PROCESS=1"}
+		executed++
+
+		return cmd
+	}
+}
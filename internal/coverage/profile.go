@@ -17,7 +17,10 @@
 package coverage
 
 import (
+	"encoding/json"
 	"go/token"
+	"io"
+	"slices"
 )
 
 // Profile is implemented as a map holding a slice of Block per each filename.
@@ -41,13 +44,42 @@ func (p Profile) IsCovered(pos token.Position) bool {
 	return false
 }
 
+// Merge adds the Block of other to the Profile, resulting in the union of the
+// covered blocks. The Block already present in the Profile are not added twice.
+func (p Profile) Merge(other Profile) {
+	for fn, blocks := range other {
+		for _, b := range blocks {
+			if slices.Contains(p[fn], b) {
+				continue
+			}
+			p[fn] = append(p[fn], b)
+		}
+	}
+}
+
+// WriteJSON writes the Profile as JSON on w, as an object mapping each file
+// name to its list of Block. It can be read back with ReadJSON.
+func (p Profile) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(p)
+}
+
+// ReadJSON reads a Profile written with WriteJSON.
+func ReadJSON(r io.Reader) (Profile, error) {
+	var p Profile
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // Block holds the start and end coordinates of a section of a source file
 // covered by tests.
 type Block struct {
-	StartLine int
-	StartCol  int
-	EndLine   int
-	EndCol    int
+	StartLine int `json:"start_line"`
+	StartCol  int `json:"start_col"`
+	EndLine   int `json:"end_line"`
+	EndCol    int `json:"end_col"`
 }
 
 func (b Block) isPositionCovered(pos token.Position) bool {
@@ -17,9 +17,12 @@
 package coverage_test
 
 import (
+	"bytes"
 	"go/token"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/go-gremlins/gremlins/internal/coverage"
 )
 
@@ -215,3 +218,82 @@ func TestIsCovered(t *testing.T) {
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	unit := coverage.Profile{
+		"file1.go": {{StartLine: 10, StartCol: 2, EndLine: 12, EndCol: 3}},
+	}
+	integration := coverage.Profile{
+		"file1.go": {
+			{StartLine: 10, StartCol: 2, EndLine: 12, EndCol: 3},
+			{StartLine: 20, StartCol: 2, EndLine: 22, EndCol: 3},
+		},
+		"file2.go": {{StartLine: 5, StartCol: 1, EndLine: 6, EndCol: 10}},
+	}
+
+	unit.Merge(integration)
+
+	want := coverage.Profile{
+		"file1.go": {
+			{StartLine: 10, StartCol: 2, EndLine: 12, EndCol: 3},
+			{StartLine: 20, StartCol: 2, EndLine: 22, EndCol: 3},
+		},
+		"file2.go": {{StartLine: 5, StartCol: 1, EndLine: 6, EndCol: 10}},
+	}
+	if !cmp.Equal(unit, want) {
+		t.Errorf(cmp.Diff(want, unit))
+	}
+	for _, pos := range []token.Position{
+		{Filename: "file1.go", Line: 11, Column: 5},
+		{Filename: "file1.go", Line: 21, Column: 5},
+		{Filename: "file2.go", Line: 5, Column: 3},
+	} {
+		if !unit.IsCovered(pos) {
+			t.Errorf("expected %s to be covered", pos)
+		}
+	}
+}
+
+func TestProfileJSON(t *testing.T) {
+	profile := coverage.Profile{
+		"pkg/file1.go": {
+			{StartLine: 3, StartCol: 14, EndLine: 5, EndCol: 2},
+			{StartLine: 8, StartCol: 1, EndLine: 8, EndCol: 20},
+		},
+		"file2.go": {{StartLine: 1, StartCol: 1, EndLine: 10, EndCol: 2}},
+	}
+
+	t.Run("it round-trips to an equivalent profile", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := profile.WriteJSON(buf); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := coverage.ReadJSON(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !cmp.Equal(got, profile) {
+			t.Errorf(cmp.Diff(profile, got))
+		}
+	})
+
+	t.Run("it writes the blocks by file name", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := (coverage.Profile{"file.go": {{StartLine: 1, StartCol: 2, EndLine: 3, EndCol: 4}}}).WriteJSON(buf); err != nil {
+			t.Fatal(err)
+		}
+
+		want := `{"file.go":[{"start_line":1,"start_col":2,"end_line":3,"end_col":4}]}` + "\n"
+		if got := buf.String(); got != want {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it fails on invalid JSON", func(t *testing.T) {
+		if _, err := coverage.ReadJSON(bytes.NewBufferString("not json")); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
@@ -61,3 +61,19 @@ func (d Diff) IsChanged(pos token.Position) bool {
 
 	return false
 }
+
+// IsChangedBetween tells whether any of the lines of the file between start
+// and end, both included, is changed.
+func (d Diff) IsChangedBetween(fileName string, start, end int) bool {
+	if len(d) == 0 {
+		return true
+	}
+
+	for _, change := range d[FileName(fileName)] {
+		if change.StartLine <= end && change.EndLine >= start {
+			return true
+		}
+	}
+
+	return false
+}
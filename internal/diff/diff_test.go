@@ -62,6 +62,35 @@ func TestDiff_IsChanged(t *testing.T) {
 	}
 }
 
+func TestDiff_IsChangedBetween(t *testing.T) {
+	d := Diff{"test": {{StartLine: 21, EndLine: 23}}}
+	tests := []struct {
+		name     string
+		d        Diff
+		fileName string
+		start    int
+		end      int
+		want     bool
+	}{
+		{name: "must be changed on empty Diff", d: Diff{}, fileName: "test", start: 1, end: 2, want: true},
+		{name: "must be changed if overlapping the start", d: d, fileName: "test", start: 10, end: 21, want: true},
+		{name: "must be changed if overlapping the end", d: d, fileName: "test", start: 23, end: 30, want: true},
+		{name: "must be changed if enclosing", d: d, fileName: "test", start: 10, end: 30, want: true},
+		{name: "must be changed if enclosed", d: d, fileName: "test", start: 22, end: 22, want: true},
+		{name: "must be unchanged if before", d: d, fileName: "test", start: 10, end: 20, want: false},
+		{name: "must be unchanged if after", d: d, fileName: "test", start: 24, end: 30, want: false},
+		{name: "must be unchanged if no such file", d: d, fileName: "test1", start: 10, end: 30, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.IsChangedBetween(tt.fileName, tt.start, tt.end)
+			if got != tt.want {
+				t.Errorf("IsChangedBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_newDiff(t *testing.T) {
 	fragments := []*gitdiff.TextFragment{fragment(21, 1)}
 
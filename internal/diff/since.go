@@ -0,0 +1,46 @@
+package diff
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+)
+
+var sinceLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// Since marks as changed only the files modified after a given time. It is a
+// lightweight alternative to Diff for repositories without git history.
+type Since time.Time
+
+func NewSince() (Since, error) {
+	return NewSinceAt(time.Now())
+}
+
+func NewSinceAt(now time.Time) (Since, error) {
+	changedSince := configuration.Get[string](configuration.UnleashChangedSinceKey)
+	if changedSince == "" {
+		return Since{}, nil
+	}
+
+	if d, err := time.ParseDuration(changedSince); err == nil {
+		return Since(now.Add(-d)), nil
+	}
+
+	for _, layout := range sinceLayouts {
+		if t, err := time.ParseInLocation(layout, changedSince, time.Local); err == nil {
+			return Since(t), nil
+		}
+	}
+
+	return Since{}, fmt.Errorf("invalid changed-since value %q, expected a duration or a timestamp", changedSince)
+}
+
+func (s Since) IsFileChanged(modTime time.Time) bool {
+	since := time.Time(s)
+	if since.IsZero() {
+		return true
+	}
+
+	return modTime.After(since)
+}
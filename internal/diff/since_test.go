@@ -0,0 +1,97 @@
+package diff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+)
+
+func TestNewSinceAt(t *testing.T) {
+	now := time.Date(2022, 10, 15, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    Since
+		wantErr bool
+	}{
+		{
+			name:  "must be zero if not set",
+			value: "",
+			want:  Since{},
+		},
+		{
+			name:  "must parse a duration",
+			value: "24h",
+			want:  Since(now.Add(-24 * time.Hour)),
+		},
+		{
+			name:  "must parse a RFC3339 timestamp",
+			value: "2022-10-14T10:30:00Z",
+			want:  Since(time.Date(2022, 10, 14, 10, 30, 0, 0, time.UTC)),
+		},
+		{
+			name:  "must parse a date",
+			value: "2022-10-14",
+			want:  Since(time.Date(2022, 10, 14, 0, 0, 0, 0, time.Local)),
+		},
+		{
+			name:    "must fail on invalid value",
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration.Set(configuration.UnleashChangedSinceKey, tt.value)
+			defer configuration.Reset()
+
+			got, err := NewSinceAt(now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSinceAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !time.Time(got).Equal(time.Time(tt.want)) {
+				t.Errorf("NewSinceAt() = %v, want %v", time.Time(got), time.Time(tt.want))
+			}
+		})
+	}
+}
+
+func TestSince_IsFileChanged(t *testing.T) {
+	since := time.Date(2022, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		s       Since
+		modTime time.Time
+		want    bool
+	}{
+		{
+			name:    "must be changed on zero Since",
+			s:       Since{},
+			modTime: since.Add(-time.Hour),
+			want:    true,
+		},
+		{
+			name:    "must be changed if modified after",
+			s:       Since(since),
+			modTime: since.Add(time.Hour),
+			want:    true,
+		},
+		{
+			name:    "must be unchanged if modified before",
+			s:       Since(since),
+			modTime: since.Add(-time.Hour),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s.IsFileChanged(tt.modTime)
+			if got != tt.want {
+				t.Errorf("IsFileChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// zeroLiterals are the zero values that replace the elements of the
+// collections, for each kind of literal.
+var zeroLiterals = map[token.Token]string{
+	token.INT:    "0",
+	token.FLOAT:  "0.0",
+	token.STRING: `""`,
+}
+
+// collectionElementSpecs builds the MutatorSpec of mutator.CollectionElement
+// for a slice, array or map literal, which replaces one of its literal
+// element values with the zero value, one mutant per element.
+//
+//	[]int{1, 2, 3}              -> []int{0, 2, 3}
+//	map[string]int{"a": 1}      -> map[string]int{"a": 0}
+//	[]string{"x", "y"}          -> []string{"", "y"}
+//
+// The keys of the maps are left alone, as the elements which already have
+// the zero value. The literals of named types are skipped, since they can't
+// be told apart from the struct ones without type information.
+func collectionElementSpecs(node ast.Node) []MutatorSpec {
+	lit, ok := node.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	switch lit.Type.(type) {
+	case *ast.ArrayType, *ast.MapType:
+	default:
+		return nil
+	}
+
+	var specs []MutatorSpec
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Value
+		}
+		value, ok := elt.(*ast.BasicLit)
+		if !ok || isZeroLiteral(value) {
+			continue
+		}
+		specs = append(specs, collectionElementSpec(lit, value))
+	}
+
+	return specs
+}
+
+func isZeroLiteral(lit *ast.BasicLit) bool {
+	switch lit.Kind {
+	case token.INT:
+		v, err := strconv.ParseInt(lit.Value, 0, 64)
+
+		return err == nil && v == 0
+	case token.FLOAT:
+		v, err := strconv.ParseFloat(lit.Value, 64)
+
+		return err == nil && v == 0
+	case token.STRING:
+		return lit.Value == `""` || lit.Value == "``"
+	default:
+		// Only the literals with a zero value in zeroLiterals are mutated.
+		return true
+	}
+}
+
+func collectionElementSpec(collection *ast.CompositeLit, lit *ast.BasicLit) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.CollectionElement,
+		Matches: func(n ast.Node) bool {
+			return n == collection
+		},
+		Pos: func(ast.Node) token.Pos {
+			return lit.Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			actual := lit.Value
+			lit.Value = zeroLiterals[lit.Kind]
+
+			return func() {
+				lit.Value = actual
+			}
+		},
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestCollectionElement(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/collection_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.CollectionElement)
+
+	if len(mutants) != 3 {
+		t.Fatalf("expected 3 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		pi, pj := mutants[i].Position(), mutants[j].Position()
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+
+		return pi.Column < pj.Column
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it zeroes the first element of a slice",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 3, Column: 20},
+			want:   strings.Replace(src, "[]int{2, 0, 5}", "[]int{0, 0, 5}", 1),
+		},
+		{
+			name:   "it zeroes the last element of a slice",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 3, Column: 26},
+			want:   strings.Replace(src, "[]int{2, 0, 5}", "[]int{2, 0, 0}", 1),
+		},
+		{
+			name:   "it zeroes the value of a map element",
+			mutant: mutants[2],
+			pos:    token.Position{Line: 5, Column: 36},
+			want:   strings.Replace(src, `"a": "alpha"`, `"a": ""`, 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestCollectionElementSkips(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+	}{
+		{
+			name: "it doesn't mutate the zero values",
+			expr: "[]float64{0.0, 0}",
+		},
+		{
+			name: "it doesn't mutate the non literal elements",
+			expr: "[]int{x, len(s)}",
+		},
+		{
+			name: "it doesn't mutate the struct literals",
+			expr: "point{1, 2}",
+		},
+		{
+			name: "it doesn't mutate the char literals",
+			expr: "[]rune{'a'}",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nvar v = " + tc.expr + "\n"
+
+			mutants := discoverMutants(t, src, mutator.CollectionElement)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
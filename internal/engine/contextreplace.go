@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// contextReplaceSpecs builds a MutatorSpec of mutator.ContextReplace for
+// each argument of a call which looks like a context, which replaces it with
+// a new background context.
+//
+//	fetch(ctx, id) -> fetch(context.Background(), id)
+//
+// The context is told apart heuristically, by the ctx name of the argument.
+// The replacement assumes the context package is imported with its own
+// name, as it is in the files that get a ctx; when it isn't, the mutant
+// doesn't build and it is reported as NOT VIABLE.
+func contextReplaceSpecs(node ast.Node) []MutatorSpec {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	for i, arg := range call.Args {
+		if id, ok := arg.(*ast.Ident); ok && id.Name == "ctx" {
+			specs = append(specs, contextReplaceSpec(call, i))
+		}
+	}
+
+	return specs
+}
+
+func contextReplaceSpec(call *ast.CallExpr, i int) MutatorSpec {
+	ctx := call.Args[i]
+
+	return MutatorSpec{
+		Type: mutator.ContextReplace,
+		Matches: func(n ast.Node) bool {
+			return n == call
+		},
+		Pos: func(ast.Node) token.Pos {
+			return ctx.Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			call.Args[i] = &ast.CallExpr{
+				Fun: &ast.SelectorExpr{
+					X:   ast.NewIdent("context"),
+					Sel: ast.NewIdent("Background"),
+				},
+			}
+
+			return func() {
+				call.Args[i] = ctx
+			}
+		},
+	}
+}
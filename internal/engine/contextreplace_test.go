@@ -0,0 +1,74 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestContextReplace(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/context_go")
+	src := string(fixture)
+
+	got, mutated := applySpecMutant(t, src, mutator.ContextReplace)
+
+	if pos := got.Position(); pos.Line != 10 || pos.Column != 15 {
+		t.Errorf("expected the mutant at 10:15, got %s", pos)
+	}
+	want := strings.Replace(src, "fetch(ctx, 1)", "fetch(context.Background(), 1)", 1)
+	if !cmp.Equal(mutated, want) {
+		t.Errorf(cmp.Diff(want, mutated))
+	}
+}
+
+func TestContextReplaceSkips(t *testing.T) {
+	testCases := []struct {
+		name string
+		stmt string
+	}{
+		{
+			name: "it doesn't mutate the arguments not named ctx",
+			stmt: "f(c, n)",
+		},
+		{
+			name: "it doesn't mutate a ctx selector",
+			stmt: "f(s.ctx, n)",
+		},
+		{
+			name: "it doesn't mutate a method call on ctx",
+			stmt: "_ = ctx.Err()",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc g(ctx, c context.Context, s server, n int) {\n\t" + tc.stmt + "\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.ContextReplace)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
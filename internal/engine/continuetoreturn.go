@@ -0,0 +1,102 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// continueToReturnSpecs builds a MutatorSpec of mutator.ContinueToReturn for
+// each continue statement in the body of a function, which replaces it with
+// a return statement.
+//
+//	continue -> return
+//
+// The function is the matched node, since a return is valid only if the
+// function has no results, or if all its results are named. The continue
+// statements in the function literals of the body belong to them, and they
+// are left to their own specs.
+func continueToReturnSpecs(node ast.Node) []MutatorSpec {
+	var fType *ast.FuncType
+	var body *ast.BlockStmt
+	switch fn := node.(type) {
+	case *ast.FuncDecl:
+		fType, body = fn.Type, fn.Body
+	case *ast.FuncLit:
+		fType, body = fn.Type, fn.Body
+	default:
+		return nil
+	}
+	if body == nil || !allowsBareReturn(fType) {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BranchStmt:
+			if stmt.Tok == token.CONTINUE {
+				specs = append(specs, continueToReturnSpec(node, stmt))
+			}
+		}
+
+		return true
+	})
+
+	return specs
+}
+
+func continueToReturnSpec(fn ast.Node, stmt *ast.BranchStmt) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.ContinueToReturn,
+		Matches: func(n ast.Node) bool {
+			return n == fn
+		},
+		Pos: func(ast.Node) token.Pos {
+			return stmt.TokPos
+		},
+		Mutate: func(ast.Node) func() {
+			label := stmt.Label
+			stmt.Tok = token.RETURN
+			stmt.Label = nil
+
+			return func() {
+				stmt.Tok = token.CONTINUE
+				stmt.Label = label
+			}
+		},
+	}
+}
+
+// allowsBareReturn tells if a return without values is valid in the function.
+func allowsBareReturn(fType *ast.FuncType) bool {
+	if fType.Results == nil {
+		return true
+	}
+	for _, field := range fType.Results.List {
+		if len(field.Names) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
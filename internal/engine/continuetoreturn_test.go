@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestContinueToReturn(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/loop_continue_return_go")
+	src := string(fixture)
+
+	got, mutated := applySpecMutant(t, src, mutator.ContinueToReturn)
+
+	if got.Position().Line != 6 || got.Position().Column != 4 {
+		t.Errorf("expected mutant at 6:4, got %s", got.Position())
+	}
+	want := strings.Replace(src, "continue", "return", 1)
+	if !cmp.Equal(mutated, want) {
+		t.Errorf(cmp.Diff(want, mutated))
+	}
+}
+
+func TestContinueToReturnLabeled(t *testing.T) {
+	src := "package main\n\nfunc main() {\nouter:\n\tfor {\n\t\tfor {\n\t\t\tcontinue outer\n\t\t}\n\t}\n}\n"
+
+	_, mutated := applySpecMutant(t, src, mutator.ContinueToReturn)
+
+	want := strings.Replace(src, "continue outer", "return", 1)
+	if !cmp.Equal(mutated, want) {
+		t.Errorf(cmp.Diff(want, mutated))
+	}
+}
+
+func TestContinueToReturnSkipsFunctionsWithResults(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+		want int
+	}{
+		{
+			name: "it skips unnamed results",
+			src:  "package main\n\nfunc f(s []int) int {\n\tfor range s {\n\t\tcontinue\n\t}\n\n\treturn 0\n}\n",
+		},
+		{
+			name: "it mutates with named results",
+			src:  "package main\n\nfunc f(s []int) (n int) {\n\tfor range s {\n\t\tcontinue\n\t}\n\n\treturn 0\n}\n",
+			want: 1,
+		},
+		{
+			name: "it mutates in a closure of a function with results",
+			src:  "package main\n\nfunc f(s []int) int {\n\tg := func() {\n\t\tfor range s {\n\t\t\tcontinue\n\t\t}\n\t}\n\tg()\n\n\treturn 0\n}\n",
+			want: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mutants := discoverMutants(t, tc.src, mutator.ContinueToReturn)
+
+			if len(mutants) != tc.want {
+				t.Errorf("expected %d mutants, got %d", tc.want, len(mutants))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// dropAppendArgSpec builds the MutatorSpec of mutator.DropAppendArg, which
+// removes the last appended value from a call to the append builtin.
+//
+//	append(s, a, b) -> append(s, a)
+//	append(s, a...) -> append(s)
+func dropAppendArgSpec() MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.DropAppendArg,
+		Matches: func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return false
+			}
+
+			return isBuiltin(call.Fun, "append")
+		},
+		Pos: func(node ast.Node) token.Pos {
+			call, _ := node.(*ast.CallExpr)
+
+			return call.Args[len(call.Args)-1].Pos()
+		},
+		Mutate: func(node ast.Node) func() {
+			call, _ := node.(*ast.CallExpr)
+			args, ellipsis := call.Args, call.Ellipsis
+			call.Args = args[:len(args)-1]
+			call.Ellipsis = token.NoPos
+
+			return func() {
+				call.Args, call.Ellipsis = args, ellipsis
+			}
+		},
+	}
+}
+
+// isBuiltin checks if the expression is the identifier of the named builtin.
+// An identifier declared in the file, which shadows the builtin, doesn't match.
+func isBuiltin(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == name && ident.Obj == nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestDropAppendArg(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/append_go")
+
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "it drops the last appended value",
+			src:  string(fixture),
+			want: "package main\n\nfunc main() {\n\ts := []int{1}\n\ts = append(s, 2)\n\t_ = s\n}\n",
+		},
+		{
+			name: "it drops the spread slice",
+			src:  "package main\n\nfunc main() {\n\ts := []int{1}\n\ts = append(s, s...)\n\t_ = s\n}\n",
+			want: "package main\n\nfunc main() {\n\ts := []int{1}\n\ts = append(s)\n\t_ = s\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, got := applySpecMutant(t, tc.src, mutator.DropAppendArg)
+
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestDropAppendArgSkipsNotMatching(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "append without values",
+			src:  "package main\n\nfunc main() {\n\ts := []int{1}\n\ts = append(s)\n\t_ = s\n}\n",
+		},
+		{
+			name: "shadowed append",
+			src:  "package main\n\nfunc main() {\n\tappend := func(a, b int) int { return a + b }\n\t_ = append(1, 2)\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mutants := discoverMutants(t, tc.src, mutator.DropAppendArg)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
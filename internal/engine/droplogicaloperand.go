@@ -0,0 +1,130 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// dropLogicalOperandSpecs builds a MutatorSpec of mutator.DropLogicalOperand
+// for each operand of the chains of && and || in the body of a function,
+// which drops the operand from the chain.
+//
+//	a && b && c -> b && c
+//	a && b && c -> a && c
+//	a && b && c -> a && b
+//
+// The function is the matched node, since dropping an operand from a chain
+// of two replaces the whole chain with the other operand, and the chain can
+// only be replaced in its parent. The chains in the function literals of the
+// body belong to them, and they are left to their own specs.
+func dropLogicalOperandSpecs(node ast.Node) []MutatorSpec {
+	var body *ast.BlockStmt
+	switch fn := node.(type) {
+	case *ast.FuncDecl:
+		body = fn.Body
+	case *ast.FuncLit:
+		body = fn.Body
+	default:
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	astutil.Apply(body, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BinaryExpr:
+			if n.Op != token.LAND && n.Op != token.LOR {
+				return true
+			}
+			if parent, ok := c.Parent().(*ast.BinaryExpr); ok && parent.Op == n.Op {
+				return true
+			}
+			operands := chainOperands(n, n.Op)
+			for i := range operands {
+				specs = append(specs, dropLogicalOperandSpec(node, body, n, operands, i))
+			}
+		}
+
+		return true
+	}, nil)
+
+	return specs
+}
+
+func dropLogicalOperandSpec(fn ast.Node, body *ast.BlockStmt, chain *ast.BinaryExpr, operands []ast.Expr, i int) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.DropLogicalOperand,
+		Matches: func(n ast.Node) bool {
+			return n == fn
+		},
+		Pos: func(ast.Node) token.Pos {
+			return operands[i].Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			var reduced ast.Expr
+			for j, operand := range operands {
+				switch {
+				case j == i:
+				case reduced == nil:
+					reduced = operand
+				default:
+					reduced = &ast.BinaryExpr{X: reduced, OpPos: chain.OpPos, Op: chain.Op, Y: operand}
+				}
+			}
+			replaceExpr(body, chain, reduced)
+
+			return func() {
+				replaceExpr(body, reduced, chain)
+			}
+		},
+	}
+}
+
+// chainOperands returns the operands of a chain of the same logical
+// operator, from left to right.
+func chainOperands(expr ast.Expr, op token.Token) []ast.Expr {
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok || bin.Op != op {
+		return []ast.Expr{expr}
+	}
+
+	return append(chainOperands(bin.X, op), chainOperands(bin.Y, op)...)
+}
+
+// replaceExpr replaces the expression from with the expression to in its
+// parent.
+func replaceExpr(root ast.Node, from, to ast.Expr) {
+	astutil.Apply(root, func(c *astutil.Cursor) bool {
+		if c.Node() == from {
+			c.Replace(to)
+
+			return false
+		}
+
+		return true
+	}, nil)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestDropLogicalOperand(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/logical_chain_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.DropLogicalOperand)
+
+	if len(mutants) != 3 {
+		t.Fatalf("expected 3 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		return mutants[i].Position().Column < mutants[j].Position().Column
+	})
+	testCases := []struct {
+		name   string
+		column int
+		want   string
+	}{
+		{
+			name:   "it drops the first operand",
+			column: 5,
+			want:   "b && c",
+		},
+		{
+			name:   "it drops a middle operand",
+			column: 10,
+			want:   "a && c",
+		},
+		{
+			name:   "it drops the last operand",
+			column: 15,
+			want:   "a && b",
+		},
+	}
+	for i, tc := range testCases {
+		tc := tc
+		m := mutants[i]
+		t.Run(tc.name, func(t *testing.T) {
+			if m.Position().Line != 5 || m.Position().Column != tc.column {
+				t.Errorf("expected mutant at 5:%d, got %s", tc.column, m.Position())
+			}
+			mutated := applyMutant(t, m, src)
+			want := strings.Replace(src, "a && b && c", tc.want, 1)
+			if !cmp.Equal(mutated, want) {
+				t.Errorf(cmp.Diff(want, mutated))
+			}
+		})
+	}
+}
+
+func TestDropLogicalOperandChains(t *testing.T) {
+	testCases := []struct {
+		name string
+		cond string
+		want []string
+	}{
+		{
+			name: "it replaces a chain of two with the other operand",
+			cond: "a || b",
+			want: []string{"a", "b"},
+		},
+		{
+			name: "it keeps the parenthesized chains apart",
+			cond: "a && (b || c)",
+			want: []string{"(b || c)", "a", "a && (b)", "a && (c)"},
+		},
+		{
+			name: "it doesn't mix different operators",
+			cond: "a && b || c",
+			want: []string{"a && b", "a || c", "b || c", "c"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc f(a, b, c bool) bool {\n\treturn " + tc.cond + "\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.DropLogicalOperand)
+
+			var got []string
+			for _, m := range mutants {
+				mutated := applyMutant(t, m, src)
+				got = append(got, strings.TrimSuffix(strings.SplitN(mutated, "\treturn ", 2)[1], "\n}\n"))
+			}
+			sort.Strings(got)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// dropStructFieldSpecs builds a MutatorSpec of mutator.DropStructField for
+// each keyed element of a composite literal, which removes the element and
+// leaves the field to its zero value.
+//
+//	T{A: a, B: b} -> T{B: b}
+//	T{A: a, B: b} -> T{A: a}
+//
+// The removal edits the Elts of the composite literal, which is the matched
+// node, and the restore puts back the original slice. A map literal keyed by
+// identifiers can't be told apart from a struct literal, and its entries
+// are dropped as well.
+func dropStructFieldSpecs(node ast.Node) []MutatorSpec {
+	lit, ok := node.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	for i, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if _, ok := kv.Key.(*ast.Ident); !ok {
+			continue
+		}
+		specs = append(specs, MutatorSpec{
+			Type: mutator.DropStructField,
+			Matches: func(n ast.Node) bool {
+				return n == lit
+			},
+			Pos: func(ast.Node) token.Pos {
+				return kv.Pos()
+			},
+			Mutate: func(ast.Node) func() {
+				actual := lit.Elts
+				lit.Elts = slices.Delete(slices.Clone(actual), i, i+1)
+
+				return func() {
+					lit.Elts = actual
+				}
+			},
+		})
+	}
+
+	return specs
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestDropStructField(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/struct_lit_go")
+	src := string(fixture)
+	// the mutated literal by the column of the dropped field
+	want := map[int]string{
+		12: "point{y: 2, z: 3}",
+		18: "point{x: 1, z: 3}",
+		24: "point{x: 1, y: 2}",
+	}
+
+	mutants := discoverMutants(t, src, mutator.DropStructField)
+
+	if len(mutants) != len(want) {
+		t.Fatalf("expected %d mutants, got %d", len(want), len(mutants))
+	}
+	for _, m := range mutants {
+		lit, ok := want[m.Position().Column]
+		if m.Position().Line != 8 || !ok {
+			t.Fatalf("unexpected mutant at %s", m.Position())
+		}
+		delete(want, m.Position().Column)
+		mutated := applyMutant(t, m, src)
+		wantSrc := "package main\n\ntype point struct {\n\tx, y, z int\n}\n\nfunc main() {\n\t_ = " + lit + "\n}\n"
+		if !cmp.Equal(mutated, wantSrc) {
+			t.Errorf(cmp.Diff(wantSrc, mutated))
+		}
+	}
+}
+
+func TestDropStructFieldSkipsUnkeyedElements(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\t_ = []int{1, 2}\n\t_ = map[string]int{\"a\": 1}\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.DropStructField)
+
+	if len(mutants) != 0 {
+		t.Errorf("expected no mutants, got %d", len(mutants))
+	}
+}
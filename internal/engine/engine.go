@@ -23,16 +23,27 @@ import (
 	"go/parser"
 	"go/token"
 	"io/fs"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-gremlins/gremlins/internal/coverage"
 	"github.com/go-gremlins/gremlins/internal/diff"
+	"github.com/go-gremlins/gremlins/internal/engine/workdir"
 	"github.com/go-gremlins/gremlins/internal/engine/workerpool"
 	"github.com/go-gremlins/gremlins/internal/exclusion"
+	"github.com/go-gremlins/gremlins/internal/log"
 	"github.com/go-gremlins/gremlins/internal/mutator"
 	"github.com/go-gremlins/gremlins/internal/report"
 
@@ -51,13 +62,121 @@ type Engine struct {
 	mutantStream chan mutator.Mutator
 	module       gomodule.GoModule
 	logger       report.MutantLogger
+	specs        []MutatorSpec
+	sampler      *rand.Rand
+	sample       float64
+	onePerLine   bool
+	failFast     bool
+
+	// flagInit makes the mutants in the init functions be flagged.
+	flagInit bool
+
+	// exportedOnly restricts the mutants to the bodies of the exported
+	// functions and methods.
+	exportedOnly bool
+
+	// diffFunctions widens the diff to the functions with changed lines,
+	// so that all of their mutants are tested.
+	diffFunctions bool
+
+	// discoveryWorkers bounds the number of files walked in parallel during
+	// the discovery of the mutants.
+	discoveryWorkers int
+
+	// serializePackages makes the mutants of the same package be tested
+	// one at a time, while the packages are tested in parallel.
+	serializePackages bool
+
+	// noSharedAST makes each mutant own a fresh parse of its file, instead
+	// of sharing the AST with the other mutants of the file.
+	noSharedAST bool
+
+	// selfCheck makes the mutants verify that they change the source.
+	selfCheck bool
+
+	// livedDiff makes the LIVED mutants keep the diff of their change.
+	livedDiff bool
+
+	// maxPerFile caps the mutants sent for each file, if not zero.
+	maxPerFile int
+
+	// integrationMode runs all the tests of the module for each mutant, so
+	// the packages without test files are tested by the others.
+	integrationMode bool
+
+	// workDir is the path of the working directory of the dealer, relative
+	// to the fs.FS, when it is inside the module.
+	workDir string
+
+	// testedDirs caches whether the directories of the module have test
+	// files, since all the mutants of a package without them would LIVE.
+	testedDirs *sync.Map
+
+	// pendingCoverage waits for the coverage gathered while the mutants are
+	// discovered. It is called once, before the first status is assigned.
+	pendingCoverage func() coverage.Profile
+	coverageOnce    *sync.Once
+
+	// excludedMutants counts the mutants found in the excluded files, when
+	// they are checked.
+	excludedMutants *atomic.Int64
+	checkExcluded   bool
+
+	// potentialMutants counts all the mutants of the files, including the
+	// ones of the disabled types.
+	potentialMutants *atomic.Int64
+
+	// packageTests counts the test files and functions of the packages
+	// walked during the discovery, by import path.
+	packageTests   map[string]report.PackageTests
+	packageTestsMu *sync.Mutex
+
+	// writes is shared by all the mutants to limit the concurrent writes.
+	writes writeLimiter
+
+	// writeRetries is the number of times the mutants retry a failed write.
+	writeRetries int
+
+	// fileMode is the permission of the files written by the mutants.
+	fileMode fs.FileMode
+
+	// testBudget is the total time the tests of the mutants can take, after
+	// which no more mutants are dispatched.
+	testBudget time.Duration
 }
 
 // CodeData is used to check if the mutant should be executed.
 type CodeData struct {
 	Cov       coverage.Profile
 	Diff      diff.Diff
+	Since     diff.Since
 	Exclusion exclusion.Rules
+	Only      mutator.Fingerprints
+
+	// Suppressed are the mutants known to be equivalent, which are reported
+	// as SKIPPED.
+	Suppressed mutator.Fingerprints
+
+	// GeneratedDirs are the directories of generated code, relative to the
+	// module root, which are not walked at all.
+	GeneratedDirs []string
+
+	// ExcludedLines matches the source lines which must not be mutated,
+	// ex. the logging statements.
+	ExcludedLines *regexp.Regexp
+
+	// DedupePriority, if set, keeps a single mutant on each position: the
+	// one of the first mutator.Type of the list among the ones applying.
+	DedupePriority []mutator.Type
+
+	// CoverageDisabled tells that the coverage has not been gathered, so
+	// all the mutants are considered covered.
+	CoverageDisabled bool
+
+	// Files, if not nil, are the only files in which the mutants are
+	// discovered, relative to the fs.FS of the Engine, instead of walking
+	// it all.
+	Files []string
 }
 
 // Option for the Engine initialization.
@@ -75,7 +194,40 @@ func New(mod gomodule.GoModule, codeData CodeData, jDealer ExecutorDealer, opts
 		codeData: codeData,
 		fs:       dirFS,
 		logger:   report.NewLogger(),
+		specs:    MutatorSpecs(),
+
+		discoveryWorkers: runtime.NumCPU(),
+		testedDirs:       &sync.Map{},
 	}
+	mut.writes = newWriteLimiter(configuration.Get[int](configuration.UnleashMaxFileWritesKey))
+	mut.writeRetries = configuration.Get[int](configuration.UnleashWriteRetriesKey)
+	mut.checkExcluded = configuration.Get[bool](configuration.UnleashWarnExcludedKey) ||
+		configuration.Get[bool](configuration.UnleashFailOnExcludedKey)
+	mut.onePerLine = configuration.Get[bool](configuration.UnleashOnePerLineKey)
+	mut.flagInit = configuration.Get[bool](configuration.UnleashFlagInitKey)
+	mut.exportedOnly = configuration.Get[bool](configuration.UnleashExportedOnlyKey)
+	mut.diffFunctions = configuration.Get[bool](configuration.UnleashDiffFunctionsKey)
+	mut.failFast = configuration.Get[bool](configuration.UnleashFailFastKey)
+	mut.testBudget, _ = time.ParseDuration(configuration.Get[string](configuration.UnleashTotalTestBudgetKey))
+	mut.noSharedAST = configuration.Get[bool](configuration.UnleashNoSharedASTKey)
+	mut.selfCheck = configuration.Get[bool](configuration.UnleashSelfCheckKey)
+	mut.livedDiff = configuration.Get[bool](configuration.UnleashLivedDiffKey)
+	mut.maxPerFile = configuration.Get[int](configuration.UnleashMaxMutantsPerFileKey)
+	mut.integrationMode = configuration.Get[bool](configuration.UnleashIntegrationMode)
+	mut.serializePackages = configuration.Get[bool](configuration.UnleashSerializePkgsKey)
+	mut.sample = configuration.Get[float64](configuration.UnleashSampleKey)
+	if mut.sample > 0 && mut.sample < 1 {
+		seed := int64(configuration.Get[int](configuration.UnleashSampleSeedKey))
+		// #nosec G404 - Sampling doesn't need a secure random generator
+		mut.sampler = rand.New(rand.NewSource(seed))
+		// The mutants are sampled in the order they are found, so the
+		// discovery must be sequential for the seed to select the same
+		// mutants across runs.
+		mut.discoveryWorkers = 1
+	}
+	mut.logger.CallingDir = mod.CallingDir
+	mut.logger.Module = mod.Name
+	mut.logger.CoverageNotGathered = codeData.CoverageDisabled
 	for _, opt := range opts {
 		mut = opt(mut)
 	}
@@ -92,68 +244,748 @@ func WithDirFs(dirFS fs.FS) Option {
 	}
 }
 
+// WithDiscoveryWorkers sets the number of files walked in parallel during
+// the discovery of the mutants.
+func WithDiscoveryWorkers(n int) Option {
+	return func(m Engine) Engine {
+		m.discoveryWorkers = n
+
+		return m
+	}
+}
+
+// WithFileMode sets the permissions of the files written by the mutants,
+// instead of 0600.
+func WithFileMode(mode fs.FileMode) Option {
+	return func(m Engine) Engine {
+		m.fileMode = mode
+
+		return m
+	}
+}
+
+// WithWorkDir excludes the working directory of the workdir.Dealer from the
+// discovery, in case it is inside the module.
+func WithWorkDir(dir string) Option {
+	return func(m Engine) Engine {
+		rel, err := filepath.Rel(filepath.Join(m.module.Root, m.module.CallingDir), dir)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			m.workDir = filepath.ToSlash(rel)
+		}
+
+		return m
+	}
+}
+
+// WithPendingCoverage makes the Engine discover the mutants while the
+// coverage is still being gathered. The wait function blocks until the
+// coverage is ready, and its profile replaces the one of the CodeData.
+func WithPendingCoverage(wait func() coverage.Profile) Option {
+	return func(m Engine) Engine {
+		m.pendingCoverage = wait
+		m.coverageOnce = &sync.Once{}
+
+		return m
+	}
+}
+
 // Run executes the mutation testing.
 //
-// It walks the fs.FS provided and checks every .go file which is not a test.
-// For each file it will scan for tokenMutations and gather all the mutants found.
+// It walks the fs.FS provided, or only the Files of the CodeData if set, and
+// checks every .go file which is not a test.
+// For each file it will scan for the registered MutatorSpec and gather all the
+// mutants found.
 func (mu *Engine) Run(ctx context.Context) report.Results {
 	mu.mutantStream = make(chan mutator.Mutator)
+	mu.excludedMutants = &atomic.Int64{}
+	mu.potentialMutants = &atomic.Int64{}
+	mu.packageTests = make(map[string]report.PackageTests)
+	mu.packageTestsMu = &sync.Mutex{}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	go func() {
 		defer close(mu.mutantStream)
-		_ = fs.WalkDir(mu.fs, ".", func(path string, _ fs.DirEntry, _ error) error {
-			isGoCode := filepath.Ext(path) == ".go" && !strings.HasSuffix(path, "_test.go")
-
-			if isGoCode && !mu.codeData.Exclusion.IsFileExcluded(path) {
-				mu.runOnFile(path)
-			}
-
-			return nil
-		})
+		mu.discover(ctx)
 	}()
 
 	start := time.Now()
-	res := mu.executeTests(ctx)
+	res := mu.executeTests(ctx, cancel)
 	res.Elapsed = time.Since(start)
 	res.Module = mu.module.Name
+	res.CallingDir = mu.module.CallingDir
+	res.ExcludedMutants = int(mu.excludedMutants.Load())
+	res.PotentialMutants = int(mu.potentialMutants.Load())
+	res.Packages = mu.packageTests
 
 	return res
 }
 
-func (mu *Engine) runOnFile(fileName string) {
+// discover walks the files of the module and sends the mutants found to the
+// mutant stream. The files are walked in parallel, at most discoveryWorkers
+// at a time, so the order of the mutants is not deterministic. The walk
+// stops once the context is done.
+func (mu *Engine) discover(ctx context.Context) {
+	sem := make(chan struct{}, max(mu.discoveryWorkers, 1))
+	wg := sync.WaitGroup{}
+	visit := func(path string, d fs.DirEntry) {
+		if filepath.Ext(path) != ".go" || ctx.Err() != nil {
+			return
+		}
+		isTest := strings.HasSuffix(path, "_test.go")
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			switch {
+			case isTest:
+				mu.countTests(path)
+			case !mu.codeData.Exclusion.IsFileExcluded(path):
+				mu.addPackageTests(filepath.Dir(path), report.PackageTests{})
+				mu.runOnFile(ctx, path, mu.isFileChanged(d))
+			case mu.checkExcluded:
+				mu.excludedMutants.Add(int64(mu.countMutations(path)))
+			}
+		}()
+	}
+	if mu.codeData.Files != nil {
+		mu.visitFiles(visit)
+	} else {
+		_ = fs.WalkDir(mu.fs, ".", func(path string, d fs.DirEntry, _ error) error {
+			if ctx.Err() != nil {
+				return fs.SkipAll
+			}
+			if d != nil && d.IsDir() && (mu.isWorkDir(path, d) || mu.isGeneratedDir(path)) {
+				return fs.SkipDir
+			}
+			visit(path, d)
+
+			return nil
+		})
+	}
+	wg.Wait()
+}
+
+// visitFiles visits the Files of the CodeData, skipping the missing ones.
+// The test files of their packages are visited as well, so that their
+// tests are counted, while the test files in the list are left to them.
+func (mu *Engine) visitFiles(visit func(path string, d fs.DirEntry)) {
+	dirs := make(map[string]bool)
+	for _, path := range mu.codeData.Files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		info, err := fs.Stat(mu.fs, path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		visit(path, fs.FileInfoToDirEntry(info))
+		dirs[filepath.Dir(path)] = true
+	}
+	for dir := range dirs {
+		entries, _ := fs.ReadDir(mu.fs, dir)
+		for _, e := range entries {
+			if !e.IsDir() && strings.HasSuffix(e.Name(), "_test.go") {
+				visit(filepath.ToSlash(filepath.Join(dir, e.Name())), e)
+			}
+		}
+	}
+}
+
+// isWorkDir tells whether the directory is the working directory of the
+// dealer, or a copy of the module made by it, which must not be mutated.
+func (mu *Engine) isWorkDir(path string, d fs.DirEntry) bool {
+	if path == "." {
+		return false
+	}
+
+	return path == mu.workDir || workdir.IsCopy(d.Name())
+}
+
+// isGeneratedDir tells whether the directory is one of the GeneratedDirs.
+// The walked paths are relative to the calling dir, while the GeneratedDirs
+// are relative to the module root.
+func (mu *Engine) isGeneratedDir(path string) bool {
+	if len(mu.codeData.GeneratedDirs) == 0 {
+		return false
+	}
+	fromRoot := filepath.ToSlash(filepath.Join(mu.module.CallingDir, path))
+
+	return slices.Contains(mu.codeData.GeneratedDirs, fromRoot)
+}
+
+func (mu *Engine) isFileChanged(d fs.DirEntry) bool {
+	if d == nil {
+		return true
+	}
+	info, err := d.Info()
+	if err != nil {
+		return true
+	}
+
+	return mu.codeData.Since.IsFileChanged(info.ModTime())
+}
+
+func (mu *Engine) parseFile(fileName string) (*token.FileSet, *ast.File) {
 	src, _ := mu.fs.Open(fileName)
 	set := token.NewFileSet()
 	file, _ := parser.ParseFile(set, fileName, src, parser.ParseComments)
 	_ = src.Close()
 
+	return set, file
+}
+
+// fileContext is the state of the file being inspected, shared by the
+// findMutations of all its nodes.
+type fileContext struct {
+	pkg  string
+	set  *token.FileSet
+	file *ast.File
+	// loops, inits and strs are the loops, the init functions and the
+	// string literals of the file, and diffFuncs the functions in the diff.
+	loops, inits, strs, diffFuncs []ast.Node
+	funcs                         []namedFunc
+	// lines keeps track of the lines that already have a mutant, when only
+	// one per line is sent, and excluded holds the lines to skip.
+	lines, excluded map[int]bool
+	changed         bool
+	limit           *mutantCap
+}
+
+// newFileContext parses the file and gathers its state, it returns nil if
+// the file can't be parsed.
+func (mu *Engine) newFileContext(fileName string, changed bool) *fileContext {
+	set, file := mu.parseFile(fileName)
+	if file == nil {
+		return nil
+	}
+
+	fc := &fileContext{
+		pkg:      mu.pkgName(fileName, file.Name.Name),
+		set:      set,
+		file:     file,
+		loops:    loopControls(file),
+		funcs:    namedFuncs(file),
+		strs:     stringLits(file),
+		excluded: mu.excludedLines(fileName),
+		changed:  changed,
+	}
+	if mu.diffFunctions {
+		fc.diffFuncs = mu.changedFuncs(set, file)
+	}
+	if mu.flagInit {
+		fc.inits = initFuncs(file)
+	}
+	if mu.onePerLine {
+		fc.lines = make(map[int]bool)
+	}
+	if mu.maxPerFile > 0 {
+		fc.limit = &mutantCap{max: mu.maxPerFile}
+	}
+
+	return fc
+}
+
+func (mu *Engine) runOnFile(ctx context.Context, fileName string, changed bool) {
+	fc := mu.newFileContext(fileName, changed)
+	if fc == nil {
+		return
+	}
+	if fc.limit != nil {
+		defer func() {
+			if fc.limit.reached {
+				log.Warnf("%s has more than %d mutants, the others are not reported\n", fileName, fc.limit.max)
+			}
+		}()
+	}
+	inspect := func(node ast.Node) bool {
+		if node == nil {
+			return true
+		}
+		mu.potentialMutants.Add(int64(mu.countPotential(node)))
+
+		return mu.findMutations(ctx, fc, node)
+	}
+	if !mu.exportedOnly {
+		ast.Inspect(fc.file, inspect)
+
+		return
+	}
+	for _, fn := range exportedFuncs(fc.file) {
+		ast.Inspect(fn, inspect)
+	}
+}
+
+// mutantCap counts the mutants sent for a file, up to max.
+type mutantCap struct {
+	max     int
+	sent    int
+	reached bool
+}
+
+// take tells if one more mutant can be sent, counting it. A nil mutantCap
+// has no limit.
+func (c *mutantCap) take() bool {
+	if c == nil {
+		return true
+	}
+	if c.sent >= c.max {
+		c.reached = true
+
+		return false
+	}
+	c.sent++
+
+	return true
+}
+
+// excludedLines returns the numbers of the source lines of the file matching
+// the ExcludedLines of the CodeData, or nil if there are none.
+func (mu *Engine) excludedLines(fileName string) map[int]bool {
+	if mu.codeData.ExcludedLines == nil {
+		return nil
+	}
+	src, err := fs.ReadFile(mu.fs, fileName)
+	if err != nil {
+		return nil
+	}
+
+	var excluded map[int]bool
+	for i, line := range strings.Split(string(src), "\n") {
+		if !mu.codeData.ExcludedLines.MatchString(line) {
+			continue
+		}
+		if excluded == nil {
+			excluded = make(map[int]bool)
+		}
+		excluded[i+1] = true
+	}
+
+	return excluded
+}
+
+// countMutations counts the mutants in the file without dispatching them.
+func (mu *Engine) countMutations(fileName string) int {
+	_, file := mu.parseFile(fileName)
+	if file == nil {
+		return 0
+	}
+
+	var count int
 	ast.Inspect(file, func(node ast.Node) bool {
-		n, ok := NewTokenNode(node)
-		if !ok {
+		if node == nil {
 			return true
 		}
-		mu.findMutations(fileName, set, file, n)
+		for _, spec := range mu.nodeSpecs(node) {
+			if specApplies(spec, node) {
+				count++
+			}
+		}
 
 		return true
 	})
+
+	return count
 }
 
-func (mu *Engine) findMutations(fileName string, set *token.FileSet, file *ast.File, node *NodeToken) {
-	mutantTypes, ok := TokenMutantType[node.Tok()]
-	if !ok {
+// findMutations sends the mutants found on the node of the file to the
+// mutant stream. When lines is not nil, only the first mutant of each line
+// is sent. The mutants on the excluded lines are not sent at all, and
+// neither are the ones positioned inside the string literals, as the struct
+// tags. The mutants in the diffFuncs are part of the diff, as well as the
+// ones on its changed lines. Once the limit of the file is reached, no more
+// mutants are sent. It returns false once the context is done, since the
+// mutant stream is no longer read.
+func (mu *Engine) findMutations(ctx context.Context, fc *fileContext, node ast.Node) bool {
+	specs := mu.nodeSpecs(node)
+	kept := mu.keptPerPosition(node, specs)
+	for i, spec := range specs {
+		if !specApplies(spec, node) {
+			continue
+		}
+		if kept != nil && kept[spec.Pos(node)] != spec.Type {
+			continue
+		}
+		if inStringLit(spec.Pos(node), fc.strs) {
+			continue
+		}
+		tm := NewSpecMutant(fc.pkg, fc.set, fc.file, node, spec)
+		tm.writes = mu.writes
+		tm.writeRetries = mu.writeRetries
+		tm.fileMode = mu.fileMode
+		tm.selfCheck = mu.selfCheck
+		tm.livedDiff = mu.livedDiff
+		if mu.codeData.Only != nil && !mu.codeData.Only.Contains(tm) {
+			continue
+		}
+		pos := fc.set.Position(tm.Pos())
+		if fc.excluded[pos.Line] {
+			continue
+		}
+		if fc.lines != nil {
+			if fc.lines[pos.Line] {
+				continue
+			}
+			fc.lines[pos.Line] = true
+		}
+		if !fc.limit.take() {
+			return true
+		}
+		inDiff := mu.codeData.Diff.IsChanged(pos) || isEnclosed(tm.Pos(), fc.diffFuncs)
+		tm.SetStatus(mu.mutationStatus(pos, fc.changed && inDiff))
+		if mu.codeData.Suppressed.Contains(tm) {
+			tm.SetStatus(mutator.Skipped)
+		}
+		tm.SetNodeKind(nodeKind(tm.Pos(), fc.loops))
+		tm.SetInInit(isEnclosed(tm.Pos(), fc.inits))
+		tm.SetFunction(enclosingFunc(tm.Pos(), fc.funcs))
+		if mu.noSharedAST && tm.Status() == mutator.Runnable {
+			mu.ownAST(tm, i)
+		}
+
+		select {
+		case mu.mutantStream <- tm:
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
+
+// ownAST makes the mutant own a fresh parse of its file. The node of the
+// mutant is found again in the new AST, and its MutatorSpec is taken by
+// index, since the ones built for the elements of the node refer to the
+// node they were built on.
+func (mu *Engine) ownAST(tm *TokenMutator, specIdx int) {
+	set, file := mu.parseFile(tm.fs.File(tm.file.Pos()).Name())
+	if file == nil {
 		return
 	}
+	var node ast.Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if node != nil || n == nil {
+			return false
+		}
+		if n.Pos() == tm.node.Pos() && n.End() == tm.node.End() && reflect.TypeOf(n) == reflect.TypeOf(tm.node) {
+			node = n
+		}
 
-	pkg := mu.pkgName(fileName, file.Name.Name)
-	for _, mt := range mutantTypes {
-		if !configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) {
-			return
+		return node == nil
+	})
+	if node == nil {
+		return
+	}
+	spec := mu.nodeSpecs(node)[specIdx]
+	tm.fs, tm.file, tm.node, tm.spec = set, file, node, &spec
+}
+
+// keptPerPosition returns, for each position of the mutants of the node,
+// the mutator.Type kept with DedupePriority, or nil if it is not set. The
+// mutants of the same position come from the same node, as the conditionals
+// boundary and negation of a comparison.
+func (mu *Engine) keptPerPosition(node ast.Node, specs []MutatorSpec) map[token.Pos]mutator.Type {
+	if mu.codeData.DedupePriority == nil {
+		return nil
+	}
+	kept := make(map[token.Pos]mutator.Type)
+	for _, spec := range specs {
+		if !specApplies(spec, node) {
+			continue
+		}
+		pos := spec.Pos(node)
+		actual, ok := kept[pos]
+		if !ok || mu.dedupeRank(spec.Type) < mu.dedupeRank(actual) {
+			kept[pos] = spec.Type
+		}
+	}
+
+	return kept
+}
+
+// dedupeRank returns the index of the mutator.Type in DedupePriority. The
+// types missing from it, as the custom ones, come last.
+func (mu *Engine) dedupeRank(mt mutator.Type) int {
+	if i := slices.Index(mu.codeData.DedupePriority, mt); i >= 0 {
+		return i
+	}
+
+	return len(mu.codeData.DedupePriority)
+}
+
+// nodeSpecs returns the MutatorSpec to check on the node: the registered
+// ones and the ones built for the elements of the node.
+func (mu *Engine) nodeSpecs(node ast.Node) []MutatorSpec {
+	specs := mu.specs
+	for _, build := range elementSpecs {
+		if s := build(node); len(s) > 0 {
+			specs = append(slices.Clip(specs), s...)
+		}
+	}
+
+	return specs
+}
+
+// countPotential counts the mutants of the node, whether their type is
+// enabled or not.
+func (mu *Engine) countPotential(node ast.Node) int {
+	var count int
+	for _, spec := range mu.nodeSpecs(node) {
+		if spec.Matches(node) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// specApplies checks if the MutatorSpec is enabled and produces a mutant on
+// the node.
+func specApplies(spec MutatorSpec, node ast.Node) bool {
+	if !spec.Matches(node) {
+		return false
+	}
+	if !configuration.Get[bool](configuration.MutantTypeEnabledKey(spec.Type)) {
+		return false
+	}
+	if !tokenTargeted(spec.Type, node) {
+		return false
+	}
+
+	return !supersededByNilCheck(spec.Type, node)
+}
+
+// tokenTargeted checks if the token of the node is among the tokens the
+// mutator.Type is restricted to, if any. It is always the case for the
+// nodes without a token.
+func tokenTargeted(mt mutator.Type, node ast.Node) bool {
+	n, ok := NewTokenNode(node)
+	if !ok {
+		return true
+	}
+	tokens := configuration.GetStrings(configuration.MutantTypeTokensKey(mt))
+
+	return len(tokens) == 0 || slices.Contains(tokens, n.Tok().String())
+}
+
+// loopControls returns the nodes of the file that control a loop: the
+// conditions and post statements of the for statements and the break and
+// continue statements.
+func loopControls(file *ast.File) []ast.Node {
+	var loops []ast.Node
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.ForStmt:
+			if n.Cond != nil {
+				loops = append(loops, n.Cond)
+			}
+			if n.Post != nil {
+				loops = append(loops, n.Post)
+			}
+		case *ast.BranchStmt:
+			if n.Tok == token.BREAK || n.Tok == token.CONTINUE {
+				loops = append(loops, n)
+			}
+		}
+
+		return true
+	})
+
+	return loops
+}
+
+// initFuncs returns the bodies of the init functions of the file.
+func initFuncs(file *ast.File) []ast.Node {
+	var inits []ast.Node
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Recv == nil && fn.Name.Name == "init" && fn.Body != nil {
+			inits = append(inits, fn.Body)
+		}
+	}
+
+	return inits
+}
+
+// exportedFuncs returns the declarations of the exported functions and
+// methods of the file. A method is exported only if its receiver type is
+// exported as well.
+func exportedFuncs(file *ast.File) []ast.Node {
+	var funcs []ast.Node
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !fn.Name.IsExported() {
+			continue
+		}
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			recv, _ := recvTypeName(fn.Recv.List[0].Type)
+			if recv == nil || !recv.IsExported() {
+				continue
+			}
 		}
-		mutantType := mt
-		tm := NewTokenMutant(pkg, set, file, node)
-		tm.SetType(mutantType)
-		tm.SetStatus(mu.mutationStatus(set.Position(node.TokPos)))
+		funcs = append(funcs, fn)
+	}
+
+	return funcs
+}
 
-		mu.mutantStream <- tm
+// changedFuncs returns the functions of the file with lines changed by the
+// diff.
+func (mu *Engine) changedFuncs(set *token.FileSet, file *ast.File) []ast.Node {
+	var funcs []ast.Node
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		start, end := set.Position(fn.Pos()), set.Position(fn.End())
+		if mu.codeData.Diff.IsChangedBetween(start.Filename, start.Line, end.Line) {
+			funcs = append(funcs, fn)
+		}
 	}
+
+	return funcs
+}
+
+// namedFunc is a function of a file with its name.
+type namedFunc struct {
+	node ast.Node
+	name string
+}
+
+// namedFuncs returns the functions of the file, each one before the function
+// literals it encloses. The methods are named after their receiver, ex.
+// (*Parser).Parse, and the function literals after their enclosing function,
+// like the Go runtime does, ex. parseConfig.func1 and parseConfig.func1.1.
+func namedFuncs(file *ast.File) []namedFunc {
+	var funcs []namedFunc
+	var addLits func(node ast.Node, prefix string)
+	addLits = func(node ast.Node, prefix string) {
+		n := 0
+		ast.Inspect(node, func(child ast.Node) bool {
+			lit, ok := child.(*ast.FuncLit)
+			if !ok || child == node {
+				return true
+			}
+			n++
+			name := fmt.Sprintf("%s%d", prefix, n)
+			funcs = append(funcs, namedFunc{node: lit, name: name})
+			addLits(lit, name+".")
+
+			return false
+		})
+	}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			addLits(decl, "func")
+
+			continue
+		}
+		name := funcDeclName(fn)
+		funcs = append(funcs, namedFunc{node: fn, name: name})
+		addLits(fn, name+".func")
+	}
+
+	return funcs
+}
+
+// funcDeclName returns the name of the function, prefixed by its receiver
+// type if it is a method.
+func funcDeclName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	ident, star := recvTypeName(fn.Recv.List[0].Type)
+	if ident == nil {
+		return fn.Name.Name
+	}
+	if star {
+		return fmt.Sprintf("(*%s).%s", ident.Name, fn.Name.Name)
+	}
+
+	return ident.Name + "." + fn.Name.Name
+}
+
+// recvTypeName returns the name of the base type of a method receiver, and
+// whether the receiver is a pointer. The name is nil if the receiver type is
+// not a plain or generic named type.
+func recvTypeName(recv ast.Expr) (*ast.Ident, bool) {
+	star := false
+	if s, ok := recv.(*ast.StarExpr); ok {
+		recv, star = s.X, true
+	}
+	// The type parameters of a generic receiver are not part of the name.
+	switch r := recv.(type) {
+	case *ast.IndexExpr:
+		recv = r.X
+	case *ast.IndexListExpr:
+		recv = r.X
+	}
+	ident, _ := recv.(*ast.Ident)
+
+	return ident, star
+}
+
+// enclosingFunc returns the name of the innermost function enclosing the
+// position, or an empty string if it is outside the functions.
+func enclosingFunc(pos token.Pos, funcs []namedFunc) string {
+	var name string
+	for _, f := range funcs {
+		if pos >= f.node.Pos() && pos < f.node.End() {
+			name = f.name
+		}
+	}
+
+	return name
+}
+
+// stringLits returns the string literals of the file, including the struct
+// tags, in source order.
+func stringLits(file *ast.File) []ast.Node {
+	var strs []ast.Node
+	ast.Inspect(file, func(node ast.Node) bool {
+		if lit, ok := node.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			strs = append(strs, lit)
+		}
+
+		return true
+	})
+
+	return strs
+}
+
+// inStringLit tells whether the position is strictly inside one of the
+// string literals, which are in source order and don't overlap. No mutation
+// makes sense there, so a spec positioning a mutant inside a literal is
+// ignored. The start of a literal is the position of the mutants of the
+// literal as a whole, as the dropped argument of an append.
+func inStringLit(pos token.Pos, strs []ast.Node) bool {
+	i := sort.Search(len(strs), func(i int) bool {
+		return strs[i].End() > pos
+	})
+
+	return i < len(strs) && pos > strs[i].Pos()
+}
+
+// isEnclosed tells whether the position is inside one of the nodes.
+func isEnclosed(pos token.Pos, nodes []ast.Node) bool {
+	for _, n := range nodes {
+		if pos >= n.Pos() && pos < n.End() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func nodeKind(pos token.Pos, loops []ast.Node) mutator.NodeKind {
+	if isEnclosed(pos, loops) {
+		return mutator.LoopControlNode
+	}
+
+	return mutator.GenericNode
 }
 
 func (mu *Engine) pkgName(fileName, fPkg string) string {
@@ -184,21 +1016,111 @@ func normalisePkgPath(pkg string) string {
 	return strings.ReplaceAll(pkg, sep, "/")
 }
 
-func (mu *Engine) mutationStatus(pos token.Position) mutator.Status {
+func (mu *Engine) mutationStatus(pos token.Position, changed bool) mutator.Status {
 	var status mutator.Status
 
-	if mu.codeData.Cov.IsCovered(pos) {
+	mu.waitCoverage()
+	if mu.codeData.CoverageDisabled || mu.codeData.Cov.IsCovered(pos) {
 		status = mutator.Runnable
 	}
 
-	if !mu.codeData.Diff.IsChanged(pos) {
+	if status == mutator.Runnable && !mu.integrationMode && !mu.hasTestFiles(filepath.Dir(pos.Filename)) {
+		status = mutator.NoTests
+	}
+
+	if !changed {
 		status = mutator.Skipped
 	}
 
 	return status
 }
 
-func (mu *Engine) executeTests(ctx context.Context) report.Results {
+// waitCoverage waits for the pending coverage, if any. The profile is set
+// only once, and the sync.Once makes it visible to all the discovery workers.
+func (mu *Engine) waitCoverage() {
+	if mu.pendingCoverage == nil {
+		return
+	}
+	mu.coverageOnce.Do(func() {
+		mu.codeData.Cov = mu.pendingCoverage()
+	})
+}
+
+// hasTestFiles tells whether the directory of the package has test files. A
+// directory that can't be read is considered tested, so that its mutants are
+// tested as usual.
+func (mu *Engine) hasTestFiles(dir string) bool {
+	if tested, ok := mu.testedDirs.Load(dir); ok {
+		return tested.(bool)
+	}
+	entries, err := fs.ReadDir(mu.fs, dir)
+	tested := err != nil
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), "_test.go") {
+			tested = true
+
+			break
+		}
+	}
+	mu.testedDirs.Store(dir, tested)
+
+	return tested
+}
+
+// countTests counts the Test functions of the test file in its package. The
+// file is parsed only up to the declarations, skipping the function bodies.
+func (mu *Engine) countTests(fileName string) {
+	src, err := fs.ReadFile(mu.fs, fileName)
+	if err != nil {
+		return
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, src, parser.SkipObjectResolution)
+	if err != nil {
+		return
+	}
+
+	tests := report.PackageTests{TestFiles: 1}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && isTestName(fn.Name.Name) {
+			tests.TestFuncs++
+		}
+	}
+	mu.addPackageTests(filepath.Dir(fileName), tests)
+}
+
+// isTestName tells whether the name is the one of a Test function, as
+// go test finds them: Test followed by a character which isn't lowercase.
+func isTestName(name string) bool {
+	rest, ok := strings.CutPrefix(name, "Test")
+	if !ok {
+		return false
+	}
+	if rest == "" {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(rest)
+
+	return !unicode.IsLower(r)
+}
+
+// addPackageTests adds the counts to the ones of the package of the
+// directory, creating it if it's the first time it's seen.
+func (mu *Engine) addPackageTests(dir string, tests report.PackageTests) {
+	pkg := normalisePkgPath(filepath.Join(mu.module.Name, mu.module.CallingDir, dir))
+
+	mu.packageTestsMu.Lock()
+	defer mu.packageTestsMu.Unlock()
+	cur := mu.packageTests[pkg]
+	cur.TestFiles += tests.TestFiles
+	cur.TestFuncs += tests.TestFuncs
+	mu.packageTests[pkg] = cur
+}
+
+// executeTests dispatches the mutants of the stream and gathers their
+// results. Calling cancel stops the run: the discovery stops, the mutants
+// already running are tested anyway and the ones not dispatched yet are
+// reported as SKIPPED, so that none of them is lost.
+func (mu *Engine) executeTests(ctx context.Context, cancel context.CancelFunc) report.Results {
 	pool := workerpool.Initialize("mutator")
 	pool.Start()
 
@@ -208,13 +1130,19 @@ func (mu *Engine) executeTests(ctx context.Context) report.Results {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		defer pool.Stop()
+		if mu.serializePackages {
+			mu.dispatchByPackage(ctx, pool, outCh, wg)
+
+			return
+		}
 		for mut := range mu.mutantStream {
-			ok := checkDone(ctx)
-			if !ok {
-				pool.Stop()
+			if !checkDone(ctx) {
+				skipMutant(mut, outCh)
 
-				break
+				continue
 			}
+			mu.sampleMutant(mut)
 			wg.Add(1)
 			pool.AppendExecutor(mu.jDealer.NewExecutor(mut, outCh, wg))
 		}
@@ -225,12 +1153,173 @@ func (mu *Engine) executeTests(ctx context.Context) report.Results {
 		close(outCh)
 	}()
 
+	var failed, exhausted bool
+	var spent time.Duration
 	for m := range outCh {
 		mu.logger.Mutant(m)
 		mutants = append(mutants, m)
+		if mu.failFast && m.Status() == mutator.Lived && !failed {
+			failed = true
+			cancel()
+		}
+		spent += m.Duration()
+		if mu.testBudget > 0 && spent >= mu.testBudget && !exhausted {
+			exhausted = true
+			log.Warnf("total test budget of %s consumed, reporting the partial results\n", mu.testBudget)
+			cancel()
+		}
+	}
+
+	res := results(mutants)
+	res.CoverageNotGathered = mu.codeData.CoverageDisabled
+	if mu.sampler != nil {
+		res.Sample = mu.sample
+	}
+
+	return res
+}
+
+// skipMutant sends the mutant, not dispatched because the run has been
+// stopped, straight to the results, as SKIPPED if it was to be tested.
+func skipMutant(mut mutator.Mutator, outCh chan<- mutator.Mutator) {
+	if mut.Status() == mutator.Runnable {
+		mut.SetStatus(mutator.Skipped)
+	}
+	outCh <- mut
+}
+
+// sampleMutant marks the covered mutant as SKIPPED if it isn't selected by
+// the sampling.
+func (mu *Engine) sampleMutant(mut mutator.Mutator) {
+	if mu.sampler != nil && mut.Status() == mutator.Runnable && mu.sampler.Float64() >= mu.sample {
+		mut.SetStatus(mutator.Skipped)
+	}
+}
+
+// dispatchByPackage tests the mutants of each package one at a time, while
+// the packages are tested in parallel, so that the mutants of a package
+// don't compile it concurrently. Each package gets a lane the first time one
+// of its mutants is found, and the lane waits for a mutant to be tested
+// before dispatching the next one, while the discovery goes on.
+func (mu *Engine) dispatchByPackage(ctx context.Context, pool *workerpool.Pool, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) {
+	lanes := make(map[string]*packageLane)
+	lanesWg := sync.WaitGroup{}
+	for mut := range mu.mutantStream {
+		if !checkDone(ctx) {
+			skipMutant(mut, outCh)
+
+			continue
+		}
+		mu.sampleMutant(mut)
+		lane, ok := lanes[mut.Pkg()]
+		if !ok {
+			lane = &packageLane{ready: make(chan struct{}, 1)}
+			lanes[mut.Pkg()] = lane
+			lanesWg.Add(1)
+			go func() {
+				defer lanesWg.Done()
+				mu.runLane(ctx, lane, pool, outCh, wg)
+			}()
+		}
+		lane.push(mut)
 	}
+	for _, lane := range lanes {
+		lane.close()
+	}
+	lanesWg.Wait()
+	// The lanes stop as soon as the run is stopped, leaving their queue.
+	for _, lane := range lanes {
+		for _, mut := range lane.queue {
+			skipMutant(mut, outCh)
+		}
+	}
+}
+
+// runLane dispatches the mutants of the lane one at a time, until the lane
+// is closed and empty or the context is done.
+func (mu *Engine) runLane(ctx context.Context, lane *packageLane, pool *workerpool.Pool, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) {
+	for {
+		mut, ok := lane.next(ctx)
+		if !ok {
+			return
+		}
+		if !checkDone(ctx) {
+			skipMutant(mut, outCh)
+
+			return
+		}
+		done := make(chan struct{})
+		wg.Add(1)
+		pool.AppendExecutor(laneExecutor{Executor: mu.jDealer.NewExecutor(mut, outCh, wg), done: done})
+		<-done
+	}
+}
+
+// packageLane is the queue of the mutants of a package waiting to be
+// tested. It never blocks the discovery, however long the queue gets.
+type packageLane struct {
+	mu     sync.Mutex
+	queue  []mutator.Mutator
+	closed bool
+	ready  chan struct{}
+}
+
+func (l *packageLane) push(mut mutator.Mutator) {
+	l.mu.Lock()
+	l.queue = append(l.queue, mut)
+	l.mu.Unlock()
+	l.signal()
+}
+
+func (l *packageLane) close() {
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+	l.signal()
+}
+
+func (l *packageLane) signal() {
+	select {
+	case l.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next mutant of the lane, waiting for one to be pushed.
+// It returns false once the lane is closed and empty, or the context done.
+func (l *packageLane) next(ctx context.Context) (mutator.Mutator, bool) {
+	for {
+		l.mu.Lock()
+		if len(l.queue) > 0 {
+			mut := l.queue[0]
+			l.queue = l.queue[1:]
+			l.mu.Unlock()
+
+			return mut, true
+		}
+		closed := l.closed
+		l.mu.Unlock()
+		if closed {
+			return nil, false
+		}
+		select {
+		case <-l.ready:
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+// laneExecutor is a workerpool.Executor that signals when it is done.
+type laneExecutor struct {
+	workerpool.Executor
+	done chan struct{}
+}
 
-	return results(mutants)
+// Start starts the wrapped workerpool.Executor and signals when it is done.
+func (e laneExecutor) Start(w *workerpool.Worker) {
+	defer close(e.done)
+	e.Executor.Start(w)
 }
 
 func checkDone(ctx context.Context) bool {
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/coverage"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestInStringLit(t *testing.T) {
+	src := "package main\n\ntype t struct {\n\tA int `json:\"a-b\"`\n}\n\nvar s = \"x+y\" + \"z\"\n"
+	set := token.NewFileSet()
+	file, err := parser.ParseFile(set, "main.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strs := stringLits(file)
+	pos := func(sub string, delta int) token.Pos {
+		return set.File(file.Pos()).Pos(strings.Index(src, sub) + delta)
+	}
+	testCases := []struct {
+		name string
+		pos  token.Pos
+		want bool
+	}{
+		{
+			name: "it is inside a struct tag",
+			pos:  pos("a-b", 1),
+			want: true,
+		},
+		{
+			name: "it is inside a string literal",
+			pos:  pos("x+y", 1),
+			want: true,
+		},
+		{
+			name: "it is not inside at the start of a literal",
+			pos:  pos(`"z"`, 0),
+		},
+		{
+			name: "it is not inside between the literals",
+			pos:  pos(`+ "z"`, 0),
+		},
+		{
+			name: "it is not inside before the literals",
+			pos:  pos("int", 0),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := inStringLit(tc.pos, strs); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNoSharedAST(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta, b := 1, 2\n\t_ = a + b\n\t_ = a * b\n}\n"
+	configuration.Set(configuration.MutantTypeEnabledKey(mutator.ArithmeticBase), true)
+	defer configuration.Reset()
+
+	testCases := []struct {
+		name        string
+		noSharedAST bool
+		wantShared  bool
+	}{
+		{
+			name:       "by default the mutants of a file share the AST",
+			wantShared: true,
+		},
+		{
+			name:        "with no-shared-ast each mutant owns its AST",
+			noSharedAST: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mu := &Engine{
+				fs: fstest.MapFS{
+					"main.go":      {Data: []byte(src)},
+					"main_test.go": {Data: []byte("package main")},
+				},
+				specs: MutatorSpecs(),
+				codeData: CodeData{
+					Cov: coverage.Profile{"main.go": {{StartLine: 1, EndLine: 8, StartCol: 1, EndCol: 1}}},
+				},
+				noSharedAST:      tc.noSharedAST,
+				mutantStream:     make(chan mutator.Mutator),
+				potentialMutants: &atomic.Int64{},
+				testedDirs:       &sync.Map{},
+			}
+			go func() {
+				defer close(mu.mutantStream)
+				mu.runOnFile(context.Background(), "main.go", true)
+			}()
+			var mutants []*TokenMutator
+			for m := range mu.mutantStream {
+				mutants = append(mutants, m.(*TokenMutator))
+			}
+
+			if len(mutants) != 2 {
+				t.Fatalf("expected 2 mutants, got %d", len(mutants))
+			}
+			if shared := mutants[0].file == mutants[1].file; shared != tc.wantShared {
+				t.Errorf("expected the AST to be shared: %v, got %v", tc.wantShared, shared)
+			}
+
+			workdir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(workdir, "main.go"), []byte(src), 0600); err != nil {
+				t.Fatal(err)
+			}
+			m := mutants[1]
+			m.SetWorkdir(workdir)
+			if err := m.Apply(); err != nil {
+				t.Fatal(err)
+			}
+			got, _ := os.ReadFile(filepath.Join(workdir, "main.go"))
+			if want := strings.Replace(src, "a * b", "a / b", 1); string(got) != want {
+				t.Errorf("expected mutated file:\n%s\ngot:\n%s", want, got)
+			}
+			if err := m.Rollback(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
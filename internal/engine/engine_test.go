@@ -17,19 +17,32 @@
 package engine_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"go/token"
 	"io"
 	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"testing/fstest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
 	"github.com/go-gremlins/gremlins/internal/coverage"
 	"github.com/go-gremlins/gremlins/internal/diff"
 	"github.com/go-gremlins/gremlins/internal/engine"
+	"github.com/go-gremlins/gremlins/internal/exclusion"
 	"github.com/go-gremlins/gremlins/internal/gomodule"
+	"github.com/go-gremlins/gremlins/internal/log"
 	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report"
 )
 
 const (
@@ -584,6 +597,43 @@ func TestSkipTestAndNonGoFiles(t *testing.T) {
 	}
 }
 
+func TestCountExcludedMutants(t *testing.T) {
+	f, _ := os.Open("testdata/fixtures/geq_go")
+	file, _ := io.ReadAll(f)
+
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:       true,
+		configuration.UnleashWarnExcludedKey: true,
+	})
+	defer viperReset()
+
+	included := engine.New(mod, engine.CodeData{}, newJobDealerStub(t), engine.WithDirFs(fstest.MapFS{
+		"file.go": {Data: file},
+	}))
+	want := len(included.Run(context.Background()).Mutants)
+	if want == 0 {
+		t.Fatal("expected the fixture to contain mutants")
+	}
+
+	codeData := engine.CodeData{Exclusion: exclusion.Rules{regexp.MustCompile("excluded")}}
+	excluded := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(fstest.MapFS{
+		"excluded.go": {Data: file},
+	}))
+	res := excluded.Run(context.Background())
+
+	if len(res.Mutants) != 0 {
+		t.Errorf("expected the mutants of excluded files not to be dispatched, got %d", len(res.Mutants))
+	}
+	if res.ExcludedMutants != want {
+		t.Errorf("expected %d mutants in the excluded files, got %d", want, res.ExcludedMutants)
+	}
+}
+
 func TestSkipNotDiffMutants(t *testing.T) {
 	t.Parallel()
 	f, _ := os.Open("testdata/fixtures/geq_go")
@@ -617,63 +667,1260 @@ func TestSkipNotDiffMutants(t *testing.T) {
 	}
 }
 
-func TestStopsOnCancel(t *testing.T) {
-	mapFS, mod, c := loadFixture(defaultFixture, ".")
-	defer c()
+func TestDiffFunctions(t *testing.T) {
+	src := "package main\n\nfunc changed(a, b int) int {\n\tif a > b {\n\t\treturn a - b\n\t}\n\n\treturn a + b\n}\n\n" +
+		"func unchanged(a, b int) int {\n\treturn a * b\n}\n"
+	sys := fstest.MapFS{
+		"file.go": {Data: []byte(src)},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:        true,
+		configuration.UnleashDiffFunctionsKey: true,
+	})
+	defer viperReset()
 
-	mut := engine.New(mod, testCodeData, newJobDealerStub(t), engine.WithDirFs(mapFS))
+	// Only the return a - b line of the changed function is in the diff.
+	codeData := engine.CodeData{
+		Diff:             diff.Diff{"file.go": {{StartLine: 5, EndLine: 5}}},
+		CoverageDisabled: true,
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
 
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel()
-	res := mut.Run(ctx)
+	var changed, unchanged int
+	for _, mutant := range res.Mutants {
+		switch mutant.Function() {
+		case "changed":
+			changed++
+			if mutant.Status() == mutator.Skipped {
+				t.Errorf("mutant of the changed function should not be skipped, at line %d", mutant.Position().Line)
+			}
+		case "unchanged":
+			unchanged++
+			if mutant.Status() != mutator.Skipped {
+				t.Errorf("mutant of the unchanged function should be skipped, got %s", mutant.Status())
+			}
+		}
+	}
+	if changed < 2 || unchanged == 0 {
+		t.Errorf("expected mutants in both functions, got %d changed and %d unchanged", changed, unchanged)
+	}
+}
 
-	if len(res.Mutants) > 0 {
-		t.Errorf("expected to receive no mutants, got %d", len(res.Mutants))
+func TestSkipNotChangedSinceFiles(t *testing.T) {
+	t.Parallel()
+	f, _ := os.Open("testdata/fixtures/geq_go")
+	file, _ := io.ReadAll(f)
+
+	since := time.Date(2022, 10, 15, 12, 0, 0, 0, time.UTC)
+	sys := fstest.MapFS{
+		"changed.go":   {Data: file, ModTime: since.Add(time.Hour)},
+		"unchanged.go": {Data: file, ModTime: since.Add(-time.Hour)},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	codeData := engine.CodeData{
+		Cov:   coverage.Profile{"changed.go": {{StartLine: 6, EndLine: 7, StartCol: 8, EndCol: 9}}},
+		Since: diff.Since(since),
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	if got := res.Mutants; len(got) == 0 {
+		t.Errorf("should receive mutants")
+	}
+
+	for _, mutant := range res.Mutants {
+		fn := mutant.Position().Filename
+		if fn == "changed.go" && mutant.Status() == mutator.Skipped {
+			t.Errorf("mutants of changed file should not be skipped")
+		}
+		if fn == "unchanged.go" && mutant.Status() != mutator.Skipped {
+			t.Errorf("mutants of unchanged file should be skipped, got %s", mutant.Status())
+		}
 	}
 }
 
-func TestPackageDiscovery(t *testing.T) {
+func TestLoopControlNodeKind(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\tfor i := 0; i < 10; i++ {\n\t\t_ = i * 2\n\t\tbreak\n\t}\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	codeData := engine.CodeData{
+		Cov: coverage.Profile{"main.go": {{StartLine: 4, EndLine: 7, StartCol: 1, EndCol: 3}}},
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	want := map[string]mutator.NodeKind{
+		"main.go:4:16": mutator.LoopControlNode, // i < 10
+		"main.go:4:23": mutator.LoopControlNode, // i++
+		"main.go:5:9":  mutator.GenericNode,     // i * 2
+		"main.go:6:3":  mutator.LoopControlNode, // break
+	}
+	got := make(map[string]mutator.NodeKind)
+	for _, m := range res.Mutants {
+		got[m.Position().String()] = m.NodeKind()
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestFlagInit(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/init_go")
+	src := string(fixture)
+
+	t.Run("it flags the mutants in init", func(t *testing.T) {
+		mutants := discoverMutantsWithConfig(t, src, mutator.ConditionalsBoundary, map[string]any{
+			configuration.UnleashFlagInitKey: true,
+		})
+
+		if len(mutants) != 2 {
+			t.Fatalf("expected 2 mutants, got %d", len(mutants))
+		}
+		for _, m := range mutants {
+			want := m.Position().Line == 6
+			if m.InInit() != want {
+				t.Errorf("expected the mutant at %s to be in init %t, got %t", m.Position(), want, m.InInit())
+			}
+		}
+	})
+
+	t.Run("it doesn't flag the mutants by default", func(t *testing.T) {
+		mutants := discoverMutants(t, src, mutator.ConditionalsBoundary)
+
+		for _, m := range mutants {
+			if m.InInit() {
+				t.Errorf("expected the mutant at %s not to be flagged", m.Position())
+			}
+		}
+	})
+}
+
+func TestMutantTypeTokens(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\ta += 2\n\ta &= 3\n}\n"
+
+	t.Run("it only mutates the configured tokens", func(t *testing.T) {
+		mutants := discoverMutantsWithConfig(t, src, mutator.RemoveSelfAssignments, map[string]any{
+			configuration.MutantTypeTokensKey(mutator.RemoveSelfAssignments): []string{"+=", "-=", "*=", "/=", "%="},
+		})
+
+		if len(mutants) != 1 {
+			t.Fatalf("expected 1 mutant, got %d", len(mutants))
+		}
+		if got := mutants[0].Position().String(); got != "main.go:5:4" {
+			t.Errorf("expected the mutant of += at main.go:5:4, got %s", got)
+		}
+	})
+
+	t.Run("it doesn't restrict the other types", func(t *testing.T) {
+		mutants := discoverMutantsWithConfig(t, src, mutator.InvertBitwiseAssignments, map[string]any{
+			configuration.MutantTypeTokensKey(mutator.RemoveSelfAssignments): []string{"+="},
+		})
+
+		if len(mutants) != 1 {
+			t.Errorf("expected 1 mutant, got %d", len(mutants))
+		}
+	})
+
+	t.Run("it mutates all the tokens by default", func(t *testing.T) {
+		mutants := discoverMutants(t, src, mutator.RemoveSelfAssignments)
+
+		if len(mutants) != 2 {
+			t.Errorf("expected 2 mutants, got %d", len(mutants))
+		}
+	})
+}
+
+func TestFunctionNames(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/func_names_go")
+
+	mutants := discoverMutants(t, string(fixture), mutator.ArithmeticBase)
+
+	want := map[string]string{
+		"main.go:6:12":  "(*parser).parse",
+		"main.go:10:9":  "parseConfig",
+		"main.go:12:12": "parseConfig.func1",
+		"main.go:18:36": "func1",
+	}
+	got := make(map[string]string)
+	for _, m := range mutants {
+		got[m.Position().String()] = m.Function()
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestNoMutantsInStringLiterals(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/struct_tag_go")
+
+	var got []string
+	for _, mt := range mutator.Types {
+		for _, m := range discoverMutants(t, string(fixture), mt) {
+			got = append(got, fmt.Sprintf("%s at %s", m.Type(), m.Position()))
+		}
+	}
+
+	want := []string{"ARITHMETIC_BASE at main.go:9:18"}
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestExportedOnly(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/exported_go")
+	src := string(fixture)
+
+	t.Run("it only mutates the exported functions", func(t *testing.T) {
+		mutants := discoverMutantsWithConfig(t, src, mutator.ArithmeticBase, map[string]any{
+			configuration.UnleashExportedOnlyKey: true,
+		})
+
+		want := []string{"Parse", "Parse.func1", "(*Parser).Next", "Stack.Len"}
+		var got []string
+		for _, m := range mutants {
+			got = append(got, m.Function())
+		}
+		sort.Strings(want)
+		sort.Strings(got)
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it mutates all the functions by default", func(t *testing.T) {
+		mutants := discoverMutants(t, src, mutator.ArithmeticBase)
+
+		if len(mutants) != 9 {
+			t.Errorf("expected 9 mutants, got %d", len(mutants))
+		}
+	})
+}
+
+func TestOnlySelectedMutants(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1 + 2\n\tb := 3 - 4\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	only := make(mutator.Fingerprints)
+	only.Add(token.Position{Filename: "main.go", Line: 5, Column: 9}, mutator.ArithmeticBase.String())
+	codeData := engine.CodeData{
+		Cov:  coverage.Profile{"main.go": {{StartLine: 4, EndLine: 5, StartCol: 1, EndCol: 12}}},
+		Only: only,
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	if len(res.Mutants) != 1 {
+		t.Fatalf("expected 1 mutant, got %d", len(res.Mutants))
+	}
+	got := res.Mutants[0]
+	if got.Type() != mutator.ArithmeticBase || got.Position().String() != "main.go:5:9" {
+		t.Errorf("expected ARITHMETIC_BASE at main.go:5:9, got %s at %s", got.Type(), got.Position())
+	}
+}
+
+func TestSuppressedMutants(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1 + 2\n\tb := 3 - 4\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	suppressed := make(mutator.Fingerprints)
+	suppressed.Add(token.Position{Filename: "main.go", Line: 5, Column: 9}, mutator.ArithmeticBase.String())
+	codeData := engine.CodeData{
+		Cov:        coverage.Profile{"main.go": {{StartLine: 4, EndLine: 5, StartCol: 1, EndCol: 12}}},
+		Suppressed: suppressed,
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	for _, m := range res.Mutants {
+		want := mutator.Runnable
+		if m.Type() == mutator.ArithmeticBase && m.Position().String() == "main.go:5:9" {
+			want = mutator.Skipped
+		}
+		if m.Status() != want {
+			t.Errorf("expected %s at %s to be %s, got %s", m.Type(), m.Position(), want, m.Status())
+		}
+	}
+}
+
+func TestDedupePerPosition(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\tif a > 2 {\n\t\ta++\n\t}\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
 	testCases := []struct {
 		name     string
-		fromPkg  string
-		wantPath string
-		intMode  bool
+		priority []mutator.Type
+		want     []string
 	}{
 		{
-			name:     "from root, normal mode",
-			fromPkg:  ".",
-			intMode:  false,
-			wantPath: "example.com",
+			name: "it keeps all the mutants by default",
+			want: []string{"CONDITIONALS_BOUNDARY at main.go:5:7", "CONDITIONALS_NEGATION at main.go:5:7", "INCREMENT_DECREMENT at main.go:6:4"},
 		},
 		{
-			name:     "from subpackage, normal mode",
-			fromPkg:  "testdata/main/fixture",
-			intMode:  false,
-			wantPath: "example.com/testdata/main",
+			name:     "it keeps the prioritized type on the same position",
+			priority: []mutator.Type{mutator.ConditionalsNegation, mutator.ConditionalsBoundary},
+			want:     []string{"CONDITIONALS_NEGATION at main.go:5:7", "INCREMENT_DECREMENT at main.go:6:4"},
+		},
+		{
+			name:     "it keeps the type with the higher priority",
+			priority: []mutator.Type{mutator.ConditionalsBoundary, mutator.ConditionalsNegation},
+			want:     []string{"CONDITIONALS_BOUNDARY at main.go:5:7", "INCREMENT_DECREMENT at main.go:6:4"},
 		},
 	}
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			viperSet(map[string]any{
-				configuration.UnleashIntegrationMode: tc.intMode,
-				configuration.UnleashTagsKey:         "tag1 tag2",
-			})
-			defer viperReset()
-			mapFS, mod, c := loadFixture(defaultFixture, tc.fromPkg)
-			defer c()
+			codeData := engine.CodeData{
+				Cov:            coverage.Profile{"main.go": {{StartLine: 4, EndLine: 7, StartCol: 1, EndCol: 4}}},
+				DedupePriority: tc.priority,
+			}
+			mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+			res := mut.Run(context.Background())
 
-			jds := newJobDealerStub(t)
-			mut := engine.New(mod, testCodeData, jds, engine.WithDirFs(mapFS))
+			var got []string
+			for _, m := range res.Mutants {
+				got = append(got, fmt.Sprintf("%s at %s", m.Type(), m.Position()))
+			}
+			sort.Strings(got)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestPotentialMutants(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1 + 2\n\tif a > 2 {\n\t\ta++\n\t}\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:                                 true,
+		configuration.MutantTypeEnabledKey(mutator.ArithmeticBase):     false,
+		configuration.MutantTypeEnabledKey(mutator.IncrementDecrement): false,
+	})
+	defer viperReset()
+	codeData := engine.CodeData{Cov: coverage.Profile{"main.go": {{StartLine: 5, EndLine: 5, StartCol: 1, EndCol: 12}}}}
 
-			_ = mut.Run(context.Background())
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
 
-			got := jds.gotMutants[0].Pkg()
+	// ARITHMETIC_BASE, INCREMENT_DECREMENT, CONDITIONALS_BOUNDARY,
+	// CONDITIONALS_NEGATION and INJECT_EARLY_RETURN
+	if res.PotentialMutants != 5 {
+		t.Errorf("expected 5 potential mutants, got %d", res.PotentialMutants)
+	}
+	if len(res.Mutants) >= res.PotentialMutants {
+		t.Errorf("expected the potential mutants to exceed the %d found", len(res.Mutants))
+	}
+}
 
-			if got != tc.wantPath {
-				t.Errorf("want %q, got %q", tc.wantPath, got)
+func TestCoverageDisabled(t *testing.T) {
+	covered := coverage.Profile{"other.go": {{StartLine: 1, StartCol: 1, EndLine: 10, EndCol: 1}}}
+	testCases := []struct {
+		name             string
+		cov              coverage.Profile
+		coverageDisabled bool
+		want             mutator.Status
+		wantNotGathered  bool
+	}{
+		{
+			name: "it is NOT COVERED if the position is not covered",
+			cov:  covered,
+			want: mutator.NotCovered,
+		},
+		{
+			name:             "it is RUNNABLE if the coverage has not been gathered",
+			cov:              covered,
+			coverageDisabled: true,
+			want:             mutator.Runnable,
+			wantNotGathered:  true,
+		},
+		{
+			name: "it is NOT COVERED if the coverage profile is empty",
+			cov:  coverage.Profile{},
+			want: mutator.NotCovered,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mapFS, mod, c := loadFixture(defaultFixture, ".")
+			defer c()
+			viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+			defer viperReset()
+
+			codeData := engine.CodeData{
+				Cov:              tc.cov,
+				CoverageDisabled: tc.coverageDisabled,
+			}
+			mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(mapFS))
+			res := mut.Run(context.Background())
 
+			if len(res.Mutants) == 0 {
+				t.Fatal("should receive mutants")
+			}
+			for _, m := range res.Mutants {
+				if m.Status() != tc.want {
+					t.Errorf("expected %s to be %s, got %s", m.Type(), tc.want, m.Status())
+				}
+			}
+			if res.CoverageNotGathered != tc.wantNotGathered {
+				t.Errorf("expected the coverage not gathered to be %v, got %v", tc.wantNotGathered, res.CoverageNotGathered)
+			}
+		})
+	}
+}
+
+func TestPackagesWithoutTests(t *testing.T) {
+	src := "package %s\n\nfunc f(a, b int) int {\n\treturn a + b\n}\n"
+	testCases := []struct {
+		name        string
+		integration bool
+		want        map[string]mutator.Status
+	}{
+		{
+			name: "it is NO TESTS if the package has no test files",
+			want: map[string]mutator.Status{
+				"example.com/tested":   mutator.Runnable,
+				"example.com/untested": mutator.NoTests,
+			},
+		},
+		{
+			name:        "it is RUNNABLE in integration mode, since the other packages run their tests",
+			integration: true,
+			want: map[string]mutator.Status{
+				"example.com/tested":   mutator.Runnable,
+				"example.com/untested": mutator.Runnable,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashDryRunKey:       true,
+				configuration.UnleashIntegrationMode: tc.integration,
+			})
+			defer viperReset()
+			sys := fstest.MapFS{
+				"tested/file.go":      {Data: []byte(fmt.Sprintf(src, "tested"))},
+				"tested/file_test.go": {Data: []byte("package tested")},
+				"untested/file.go":    {Data: []byte(fmt.Sprintf(src, "untested"))},
+			}
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			codeData := engine.CodeData{CoverageDisabled: true}
+			mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+			res := mut.Run(context.Background())
+
+			if len(res.Mutants) != 2 {
+				t.Fatalf("expected 2 mutants, got %d", len(res.Mutants))
+			}
+			for _, m := range res.Mutants {
+				if want := tc.want[m.Pkg()]; m.Status() != want {
+					t.Errorf("expected mutant of %s to be %s, got %s", m.Pkg(), want, m.Status())
+				}
+			}
+		})
+	}
+}
+
+func TestPackageTests(t *testing.T) {
+	src := "package pkg\n\nfunc f(a int) int {\n\treturn a + 1\n}\n"
+	sys := fstest.MapFS{
+		"main.go":    {Data: []byte("package main\n\nfunc main() {}\n")},
+		"pkg/pkg.go": {Data: []byte(src)},
+		"pkg/pkg_test.go": {Data: []byte("package pkg\n\nimport \"testing\"\n\n" +
+			"func TestF(t *testing.T) {}\n\nfunc Test(t *testing.T) {}\n\nfunc Testify() {}\n\nfunc helper() {}\n")},
+		"pkg/ext_test.go": {Data: []byte("package pkg_test\n\nimport \"testing\"\n\n" +
+			"func TestExt(t *testing.T) {}\n\ntype s struct{}\n\nfunc (s) TestMethod() {}\n")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	want := map[string]report.PackageTests{
+		"example.com":     {},
+		"example.com/pkg": {TestFiles: 2, TestFuncs: 3},
+	}
+	if !cmp.Equal(res.Packages, want) {
+		t.Errorf(cmp.Diff(want, res.Packages))
+	}
+}
+
+func TestSampling(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("package main\n\nfunc main() {\n\ta := 0\n")
+	for i := 0; i < 200; i++ {
+		src.WriteString("\ta = a + 1\n")
+	}
+	src.WriteString("}\n")
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src.String())},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+
+	sample := func() []string {
+		viperSet(map[string]any{
+			configuration.UnleashDryRunKey:     true,
+			configuration.UnleashSampleKey:     0.25,
+			configuration.UnleashSampleSeedKey: 42,
+		})
+		defer viperReset()
+		mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+		res := mut.Run(context.Background())
+
+		if res.Sample != 0.25 {
+			t.Errorf("expected sample to be reported as 0.25, got %v", res.Sample)
+		}
+		var sampled []string
+		for _, m := range res.Mutants {
+			if m.Status() == mutator.Runnable {
+				sampled = append(sampled, m.Position().String())
+			}
+		}
+		sort.Strings(sampled)
+
+		return sampled
+	}
+
+	first := sample()
+	if len(first) < 25 || len(first) > 75 {
+		t.Errorf("expected about 50 sampled mutants out of 200, got %d", len(first))
+	}
+	if second := sample(); !cmp.Equal(first, second) {
+		t.Errorf("expected the same mutants to be sampled with the same seed:\n%s", cmp.Diff(first, second))
+	}
+}
+
+func TestOnePerLine(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta, b := 1, 2\n\tif a+b > 0 && a-b < 0 {\n\t\ta++\n\t}\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+
+	discover := func(onePerLine bool) map[int]int {
+		viperSet(map[string]any{
+			configuration.UnleashDryRunKey:     true,
+			configuration.UnleashOnePerLineKey: onePerLine,
+		})
+		defer viperReset()
+		mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+		res := mut.Run(context.Background())
+
+		perLine := make(map[int]int)
+		for _, m := range res.Mutants {
+			perLine[m.Position().Line]++
+		}
+
+		return perLine
+	}
+
+	if all := discover(false); all[5] < 2 {
+		t.Fatalf("expected many mutants on line 5 without one-per-line, got %d", all[5])
+	}
+	want := map[int]int{5: 1, 6: 1}
+	if got := discover(true); !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestExcludedLines(t *testing.T) {
+	src := "package main\n\nimport \"log\"\n\nfunc main() {\n\ta := 1\n\tlog.Println(a + 1)\n\ta = a - 1\n}\n"
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{
+		CoverageDisabled: true,
+		ExcludedLines:    regexp.MustCompile(`log\.`),
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	if len(res.Mutants) == 0 {
+		t.Fatal("expected mutants on the lines not excluded")
+	}
+	for _, m := range res.Mutants {
+		if m.Position().Line == 7 {
+			t.Errorf("expected no mutant on the logging line, got %s", m.Type())
+		}
+	}
+}
+
+func TestSkipWorkDirs(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\ta = a + 1\n}\n"
+	sys := fstest.MapFS{
+		"main.go":                  {Data: []byte(src)},
+		"main_test.go":             {Data: []byte("package main")},
+		"wd-1234/main.go":          {Data: []byte(src)},
+		"wd-1234/main_test.go":     {Data: []byte("package main")},
+		"tmp/gremlins-1/main.go":   {Data: []byte(src)},
+		"wd-tools/main.go":         {Data: []byte(src)},
+		"wd-tools/main_test.go":    {Data: []byte("package main")},
+		"tmp/gremlins-1/wd-1/x.go": {Data: []byte(src)},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys), engine.WithWorkDir("tmp/gremlins-1"))
+	res := mut.Run(context.Background())
+
+	files := make(map[string]bool)
+	for _, m := range res.Mutants {
+		files[m.Position().Filename] = true
+	}
+	want := map[string]bool{"main.go": true, "wd-tools/main.go": true}
+	if !cmp.Equal(files, want) {
+		t.Errorf(cmp.Diff(want, files))
+	}
+}
+
+func TestSkipGeneratedDirs(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\ta = a + 1\n}\n"
+	sys := fstest.MapFS{
+		"main.go":               {Data: []byte(src)},
+		"main_test.go":          {Data: []byte("package main")},
+		"gen/api/api.go":        {Data: []byte(src)},
+		"gen/api/api_test.go":   {Data: []byte("package main")},
+		"proto/proto.go":        {Data: []byte(src)},
+		"proto/proto_test.go":   {Data: []byte("package main")},
+		"proto/gen/gen.go":      {Data: []byte(src)},
+		"proto/gen/gen_test.go": {Data: []byte("package main")},
+	}
+	testCases := []struct {
+		name       string
+		callingDir string
+		want       map[string]bool
+	}{
+		{
+			name:       "from the module root",
+			callingDir: ".",
+			want:       map[string]bool{"main.go": true, "proto/proto.go": true},
+		},
+		{
+			// The generated dirs are relative to the module root, while the
+			// fs.FS is the one of the calling dir.
+			name:       "from a sub dir",
+			callingDir: "pkg",
+			want:       map[string]bool{"main.go": true, "gen/api/api.go": true, "proto/proto.go": true},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: tc.callingDir,
+			}
+			codeData := engine.CodeData{
+				CoverageDisabled: true,
+				GeneratedDirs:    []string{"gen", "proto/gen", "pkg/proto/gen"},
+			}
+			viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+			defer viperReset()
+
+			mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+			res := mut.Run(context.Background())
+
+			files := make(map[string]bool)
+			for _, m := range res.Mutants {
+				files[m.Position().Filename] = true
+			}
+			if !cmp.Equal(files, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, files))
+			}
+		})
+	}
+}
+
+func TestDiscoverOnlyFiles(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\ta = a + 1\n}\n"
+	test := "package main\n\nfunc TestMain(t *testing.T) {}\n"
+	sys := fstest.MapFS{
+		"main.go":             {Data: []byte(src)},
+		"main_test.go":        {Data: []byte(test)},
+		"other.go":            {Data: []byte(src)},
+		"pkg/pkg.go":          {Data: []byte(src)},
+		"pkg/pkg_test.go":     {Data: []byte(test)},
+		"pkg/skipped.go":      {Data: []byte(src)},
+		"notlisted/file.go":   {Data: []byte(src)},
+		"notlisted/x_test.go": {Data: []byte(test)},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{
+		CoverageDisabled: true,
+		Files:            []string{"main.go", "pkg/pkg.go", "pkg/pkg_test.go", "missing.go", "README.md"},
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	files := make(map[string]bool)
+	for _, m := range res.Mutants {
+		files[m.Position().Filename] = true
+	}
+	want := map[string]bool{"main.go": true, "pkg/pkg.go": true}
+	if !cmp.Equal(files, want) {
+		t.Errorf(cmp.Diff(want, files))
+	}
+	wantPackages := map[string]report.PackageTests{
+		"example.com":     {TestFiles: 1, TestFuncs: 1},
+		"example.com/pkg": {TestFiles: 1, TestFuncs: 1},
+	}
+	if !cmp.Equal(res.Packages, wantPackages) {
+		t.Errorf(cmp.Diff(wantPackages, res.Packages))
+	}
+}
+
+func TestMaxMutantsPerFile(t *testing.T) {
+	big := "package main\n\nfunc main() {\n\ta := 1 + 2 + 3 + 4 + 5 + 6\n\t_ = a\n}\n"
+	small := "package main\n\nfunc f() {\n\tb := 1 + 2\n\t_ = b\n}\n"
+	sys := fstest.MapFS{
+		"big.go":       {Data: []byte(big)},
+		"small.go":     {Data: []byte(small)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:            true,
+		configuration.UnleashMaxMutantsPerFileKey: 3,
+	})
+	defer viperReset()
+	errOut := &bytes.Buffer{}
+	log.Init(&bytes.Buffer{}, errOut)
+	defer log.Reset()
+
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	perFile := make(map[string]int)
+	for _, m := range res.Mutants {
+		perFile[m.Position().Filename]++
+	}
+	want := map[string]int{"big.go": 3, "small.go": 1}
+	if !cmp.Equal(perFile, want) {
+		t.Errorf(cmp.Diff(want, perFile))
+	}
+	warnings := errOut.String()
+	if !strings.Contains(warnings, "big.go has more than 3 mutants") {
+		t.Errorf("expected a warning about the capped file, got %q", warnings)
+	}
+	if strings.Contains(warnings, "small.go") {
+		t.Errorf("expected no warning about the file under the cap, got %q", warnings)
+	}
+}
+
+func TestParallelDiscovery(t *testing.T) {
+	sys := fstest.MapFS{}
+	for i := 0; i < 20; i++ {
+		src := fmt.Sprintf("package pkg%d\n\nfunc f(a, b int) bool {\n\ta++\n\treturn a+b > %d && a-b < 0\n}\n", i, i)
+		sys[fmt.Sprintf("pkg%d/file.go", i)] = &fstest.MapFile{Data: []byte(src)}
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{CoverageDisabled: true}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	discover := func(workers int) []string {
+		mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys), engine.WithDiscoveryWorkers(workers))
+		res := mut.Run(context.Background())
+
+		fps := make([]string, 0, len(res.Mutants))
+		for _, m := range res.Mutants {
+			fps = append(fps, mutator.NewFingerprint(m.Position(), m.Type().String()))
+		}
+		sort.Strings(fps)
+
+		return fps
+	}
+
+	want := discover(1)
+	if len(want) == 0 {
+		t.Fatal("expected mutants to be found")
+	}
+	if got := discover(8); !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestPendingCoverage(t *testing.T) {
+	sys := fstest.MapFS{}
+	for i := 0; i < 10; i++ {
+		src := fmt.Sprintf("package pkg%d\n\nfunc f(a, b int) bool {\n\ta++\n\treturn a+b > %d\n}\n", i, i)
+		sys[fmt.Sprintf("pkg%d/file.go", i)] = &fstest.MapFile{Data: []byte(src)}
+		sys[fmt.Sprintf("pkg%d/file_test.go", i)] = &fstest.MapFile{Data: []byte(fmt.Sprintf("package pkg%d", i))}
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+
+	// The coverage completes only once the discovery asks for it, so the
+	// test hangs if the two don't overlap.
+	discovering := make(chan struct{})
+	var profile coverage.Profile
+	ready := make(chan struct{})
+	go func() {
+		<-discovering
+		profile = coverage.Profile{"pkg0/file.go": {{StartLine: 4, EndLine: 5, StartCol: 1, EndCol: 20}}}
+		close(ready)
+	}()
+	var once sync.Once
+	wait := func() coverage.Profile {
+		once.Do(func() { close(discovering) })
+		<-ready
+
+		return profile
+	}
+
+	mut := engine.New(mod, engine.CodeData{}, newJobDealerStub(t), engine.WithDirFs(sys),
+		engine.WithDiscoveryWorkers(8), engine.WithPendingCoverage(wait))
+	res := mut.Run(context.Background())
+
+	if len(res.Mutants) == 0 {
+		t.Fatal("expected mutants to be found")
+	}
+	for _, m := range res.Mutants {
+		want := mutator.NotCovered
+		if m.Position().Filename == "pkg0/file.go" {
+			want = mutator.Runnable
+		}
+		if m.Status() != want {
+			t.Errorf("expected %s at %s to be %s, got %s", m.Type(), m.Position(), want, m.Status())
+		}
+	}
+}
+
+func TestFailFast(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("package main\n\nfunc main() {\n\ta := 0\n")
+	for i := 0; i < 100; i++ {
+		src.WriteString("\ta = a + 1\n")
+	}
+	src.WriteString("}\n")
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src.String())},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashFailFastKey: true,
+		configuration.UnleashWorkersKey:  1,
+	})
+	defer viperReset()
+	jds := &livedDealerStub{}
+
+	mut := engine.New(mod, engine.CodeData{CoverageDisabled: true}, jds, engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	var lived int
+	for _, m := range res.Mutants {
+		switch m.Status() {
+		case mutator.Lived:
+			lived++
+		case mutator.Skipped:
+		default:
+			t.Errorf("expected the mutants not tested to be SKIPPED, got %s", m.Status())
+		}
+	}
+	// The mutants already dispatched when the first one lived are tested
+	// anyway, and reported.
+	if lived == 0 || lived != jds.dealt {
+		t.Errorf("expected the %d tested mutants to be reported, got %d", jds.dealt, lived)
+	}
+	if jds.dealt >= 100 {
+		t.Errorf("expected the run to stop early, got %d mutants tested", jds.dealt)
+	}
+}
+
+func TestTotalTestBudget(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("package main\n\nfunc main() {\n\ta := 0\n")
+	for i := 0; i < 100; i++ {
+		src.WriteString("\ta = a + 1\n")
+	}
+	src.WriteString("}\n")
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src.String())},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashTotalTestBudgetKey: "50ms",
+		configuration.UnleashWorkersKey:         1,
+	})
+	defer viperReset()
+	jds := &slowDealerStub{delay: 10 * time.Millisecond}
+	goroutines := runtime.NumGoroutine()
+
+	mut := engine.New(mod, engine.CodeData{CoverageDisabled: true}, jds, engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	// With a tag matrix, several runs happen in the same process, so a
+	// stopped run must not leave its discovery behind.
+	checkGoroutinesLeft(t, goroutines)
+
+	var killed int
+	for _, m := range res.Mutants {
+		switch m.Status() {
+		case mutator.Killed:
+			killed++
+		case mutator.Skipped:
+		default:
+			t.Errorf("expected the reported mutants to be tested or SKIPPED, got %s", m.Status())
+		}
+	}
+	if killed < 5 || killed >= 100 {
+		t.Fatalf("expected the partial results after 5 mutants, got %d mutants tested", killed)
+	}
+}
+
+// checkGoroutinesLeft checks that the goroutines started since there were
+// the given number of them have returned, giving them a moment to do so.
+func checkGoroutinesLeft(t *testing.T, before int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if left := runtime.NumGoroutine() - before; left > 0 {
+		t.Errorf("expected no goroutine left after the run, got %d", left)
+	}
+}
+
+func TestStopsOnDeadline(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("package main\n\nfunc main() {\n\ta := 0\n")
+	for i := 0; i < 100; i++ {
+		src.WriteString("\ta = a + 1\n")
+	}
+	src.WriteString("}\n")
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src.String())},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{configuration.UnleashWorkersKey: 1})
+	defer viperReset()
+	jds := &slowDealerStub{delay: 10 * time.Millisecond}
+	goroutines := runtime.NumGoroutine()
+
+	mut := engine.New(mod, engine.CodeData{CoverageDisabled: true}, jds, engine.WithDirFs(sys))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	res := mut.Run(ctx)
+
+	checkGoroutinesLeft(t, goroutines)
+
+	var killed int
+	for _, m := range res.Mutants {
+		switch m.Status() {
+		case mutator.Killed:
+			killed++
+		case mutator.Skipped:
+		default:
+			t.Errorf("expected the reported mutants to be tested or SKIPPED, got %s", m.Status())
+		}
+	}
+	if killed == 0 || killed >= 100 {
+		t.Fatalf("expected the partial results, got %d mutants tested", killed)
+	}
+}
+
+func TestSerializePackages(t *testing.T) {
+	src := "package %s\n\nfunc f(a, b int) int {\n\treturn a + b - 1\n}\n"
+	sys := fstest.MapFS{
+		"pkg1/file.go": {Data: []byte(fmt.Sprintf(src, "pkg1"))},
+		"pkg2/file.go": {Data: []byte(fmt.Sprintf(src, "pkg2"))},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	viperSet(map[string]any{
+		configuration.UnleashSerializePkgsKey: true,
+		configuration.UnleashWorkersKey:       4,
+	})
+	defer viperReset()
+	jds := &concurrencyDealerStub{active: map[string]int{}, maxActive: map[string]int{}}
+
+	mut := engine.New(mod, engine.CodeData{CoverageDisabled: true}, jds, engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	perPkg := make(map[string]int)
+	for _, m := range res.Mutants {
+		perPkg[m.Pkg()]++
+	}
+	if perPkg["example.com/pkg1"] < 2 || perPkg["example.com/pkg2"] < 2 {
+		t.Fatalf("expected many mutants for each package, got %v", perPkg)
+	}
+	want := map[string]int{"example.com/pkg1": 1, "example.com/pkg2": 1}
+	if !cmp.Equal(jds.maxActive, want) {
+		t.Errorf("expected the mutants of a package to be tested one at a time: %s", cmp.Diff(want, jds.maxActive))
+	}
+	if jds.maxTotal != 2 {
+		t.Errorf("expected the packages to be tested in parallel, got %d at most at the same time", jds.maxTotal)
+	}
+}
+
+func TestSerializePackagesStopsOnCancel(t *testing.T) {
+	mapFS, mod, c := loadFixture(defaultFixture, ".")
+	defer c()
+	viperSet(map[string]any{configuration.UnleashSerializePkgsKey: true})
+	defer viperReset()
+
+	mut := engine.New(mod, testCodeData, newJobDealerStub(t), engine.WithDirFs(mapFS))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res := mut.Run(ctx)
+
+	if len(res.Mutants) > 0 {
+		t.Errorf("expected to receive no mutants, got %d", len(res.Mutants))
+	}
+}
+
+func TestStopsOnCancel(t *testing.T) {
+	mapFS, mod, c := loadFixture(defaultFixture, ".")
+	defer c()
+
+	mut := engine.New(mod, testCodeData, newJobDealerStub(t), engine.WithDirFs(mapFS))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res := mut.Run(ctx)
+
+	if len(res.Mutants) > 0 {
+		t.Errorf("expected to receive no mutants, got %d", len(res.Mutants))
+	}
+}
+
+func TestPackageDiscovery(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fromPkg  string
+		wantPath string
+		intMode  bool
+	}{
+		{
+			name:     "from root, normal mode",
+			fromPkg:  ".",
+			intMode:  false,
+			wantPath: "example.com",
+		},
+		{
+			name:     "from subpackage, normal mode",
+			fromPkg:  "testdata/main/fixture",
+			intMode:  false,
+			wantPath: "example.com/testdata/main",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashIntegrationMode: tc.intMode,
+				configuration.UnleashTagsKey:         "tag1 tag2",
+			})
+			defer viperReset()
+			mapFS, mod, c := loadFixture(defaultFixture, tc.fromPkg)
+			defer c()
+
+			jds := newJobDealerStub(t)
+			mut := engine.New(mod, testCodeData, jds, engine.WithDirFs(mapFS))
+
+			res := mut.Run(context.Background())
+
+			got := jds.gotMutants[0].Pkg()
+
+			if got != tc.wantPath {
+				t.Errorf("want %q, got %q", tc.wantPath, got)
+
+			}
+			if res.CallingDir != mod.CallingDir {
+				t.Errorf("want calling dir %q, got %q", mod.CallingDir, res.CallingDir)
+			}
+		})
+	}
+}
+
+func TestClosureMutants(t *testing.T) {
+	const fixture = "testdata/fixtures/closure_go"
+	testCases := []struct {
+		name    string
+		fromPkg string
+		wantPkg string
+	}{
+		{
+			name:    "from root",
+			fromPkg: ".",
+			wantPkg: "example.com",
+		},
+		{
+			name:    "from subpackage",
+			fromPkg: "testdata/main/fixture",
+			wantPkg: "example.com/testdata/main",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+			defer viperReset()
+			mapFS, mod, c := loadFixture(fixture, tc.fromPkg)
+			defer c()
+			cov := coverage.Profile{filenameFromFixture(fixture): {{StartLine: 4, EndLine: 10, StartCol: 28, EndCol: 3}}}
+
+			mut := engine.New(mod, engine.CodeData{Cov: cov}, newJobDealerStub(t), engine.WithDirFs(mapFS))
+			res := mut.Run(context.Background())
+
+			for _, m := range res.Mutants {
+				if m.Type() != mutator.ConditionalsBoundary {
+					continue
+				}
+				if m.Position().Line != 5 || m.Position().Column != 8 {
+					t.Errorf("expected mutant at 5:8, got %s", m.Position())
+				}
+				if m.Status() != mutator.Runnable {
+					t.Errorf("expected status %s, got %s", mutator.Runnable, m.Status())
+				}
+				if m.Pkg() != tc.wantPkg {
+					t.Errorf("expected package %q, got %q", tc.wantPkg, m.Pkg())
+				}
+
+				return
 			}
+			t.Errorf("expected a %s mutant inside the closure", mutator.ConditionalsBoundary)
 		})
 	}
 }
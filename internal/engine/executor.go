@@ -17,6 +17,7 @@
 package engine
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -59,7 +60,14 @@ type MutantExecutorDealer struct {
 	testExecutionTime time.Duration
 	dryRun            bool
 	integrationMode   bool
+	isolateGoCache    bool
+	buildCacheDir     string
+	testJSON          bool
 	testCPU           int
+	dumpMutant        string
+	dumpMutantOut     string
+	confirmKills      bool
+	notViableRetries  int
 }
 
 // ExecutorDealerOption is the defining option for the initialisation of a ExecutorDealer.
@@ -79,8 +87,15 @@ func NewExecutorDealer(mod gomodule.GoModule, wdd workdir.Dealer, elapsed time.D
 	buildTags := configuration.Get[string](configuration.UnleashTagsKey)
 	dryRun := configuration.Get[bool](configuration.UnleashDryRunKey)
 	integrationMode := configuration.Get[bool](configuration.UnleashIntegrationMode)
+	isolateGoCache := configuration.Get[bool](configuration.UnleashIsolateGoCacheKey)
+	buildCacheDir := configuration.Get[string](configuration.UnleashBuildCacheDirKey)
+	testJSON := configuration.Get[bool](configuration.UnleashTestJSONKey)
 	testCPU := configuration.Get[int](configuration.UnleashTestCPUKey)
 	tCoefficient := configuration.Get[int](configuration.UnleashTimeoutCoefficientKey)
+	dumpMutant := configuration.Get[string](configuration.UnleashDumpMutantKey)
+	dumpMutantOut := configuration.Get[string](configuration.UnleashDumpMutantOutKey)
+	confirmKills := configuration.Get[bool](configuration.UnleashConfirmKillsKey)
+	notViableRetries := configuration.Get[int](configuration.UnleashNotViableRetriesKey)
 
 	coefficient := DefaultTimeoutCoefficient
 	if tCoefficient != 0 {
@@ -97,8 +112,15 @@ func NewExecutorDealer(mod gomodule.GoModule, wdd workdir.Dealer, elapsed time.D
 		buildTags:         buildTags,
 		dryRun:            dryRun,
 		integrationMode:   integrationMode,
+		isolateGoCache:    isolateGoCache,
+		buildCacheDir:     buildCacheDir,
+		testJSON:          testJSON,
 		testCPU:           testCPU,
 		testExecutionTime: elapsed * time.Duration(coefficient),
+		dumpMutant:        dumpMutant,
+		dumpMutantOut:     dumpMutantOut,
+		confirmKills:      confirmKills,
+		notViableRetries:  notViableRetries,
 		execContext:       exec.CommandContext,
 	}
 
@@ -122,10 +144,17 @@ func (m MutantExecutorDealer) NewExecutor(mut mutator.Mutator, outCh chan<- muta
 		module:            m.mod,
 		dryRun:            m.dryRun,
 		integrationMode:   m.integrationMode,
+		isolateGoCache:    m.isolateGoCache,
+		buildCacheDir:     m.buildCacheDir,
+		testJSON:          m.testJSON,
 		buildTags:         m.buildTags,
 		execContext:       m.execContext,
 		testCPU:           m.testCPU,
 		testExecutionTime: m.testExecutionTime,
+		dumpMutant:        m.dumpMutant,
+		dumpMutantOut:     m.dumpMutantOut,
+		confirmKills:      m.confirmKills,
+		notViableRetries:  m.notViableRetries,
 	}
 
 	return &mj
@@ -144,7 +173,14 @@ type mutantExecutor struct {
 	testExecutionTime time.Duration
 	dryRun            bool
 	integrationMode   bool
+	isolateGoCache    bool
+	buildCacheDir     string
+	testJSON          bool
 	testCPU           int
+	dumpMutant        string
+	dumpMutantOut     string
+	confirmKills      bool
+	notViableRetries  int
 }
 
 // Start is the implementation of the workerpool.Executor definition and is the
@@ -157,8 +193,23 @@ type mutantExecutor struct {
 // The timeout of the test is managed outside the run of the test, using
 // a context with timeout. This is done because the Go test command doesn't
 // make it easy to distinguish failures from timeouts.
+// When the kills are confirmed, the tests of a KILLED mutant are run again
+// after the rollback, and the kill is flagged as suspect if they fail
+// without the mutation as well.
+// A NOT VIABLE mutant is built and tested again up to notViableRetries times,
+// since the build failure can be transient, ex. because of a flaky tool.
+// A mutant that the self-check finds not to change the source is SKIPPED.
 func (m *mutantExecutor) Start(w *workerpool.Worker) {
 	defer m.wg.Done()
+	// The mutants that aren't tested don't need a working directory, so in
+	// dry-run the source code is never copied.
+	if m.mutant.Status() == mutator.NotCovered || m.mutant.Status() == mutator.Skipped ||
+		m.mutant.Status() == mutator.NoTests || m.dryRun {
+		m.outCh <- m.mutant
+
+		return
+	}
+
 	workerName := fmt.Sprintf("%s-%d", w.Name, w.ID)
 	rootDir, err := m.wdDealer.Get(workerName)
 	if err != nil {
@@ -168,55 +219,160 @@ func (m *mutantExecutor) Start(w *workerpool.Worker) {
 	workingDir := filepath.Join(rootDir, m.module.CallingDir)
 	m.mutant.SetWorkdir(workingDir)
 
-	if m.mutant.Status() == mutator.NotCovered || m.mutant.Status() == mutator.Skipped || m.dryRun {
-		m.outCh <- m.mutant
-
-		return
-	}
-
 	if err := m.mutant.Apply(); err != nil {
+		if errors.Is(err, ErrSourceUnchanged) {
+			m.mutant.SetStatus(mutator.Skipped)
+			m.outCh <- m.mutant
+
+			return
+		}
 		log.Errorf("failed to apply mutation at %s - %s\n\t%v", m.mutant.Position(), m.mutant.Status(), err)
 
 		return
 	}
+	if m.isDumped() {
+		m.dump()
+	}
 
-	m.mutant.SetStatus(m.runTests(rootDir, m.mutant.Pkg()))
+	start := time.Now()
+	status := m.runTests(rootDir, m.mutant.Pkg())
+	for i := 0; status == mutator.NotViable && i < m.notViableRetries; i++ {
+		status = m.runTests(rootDir, m.mutant.Pkg())
+	}
+	m.mutant.SetStatus(status)
+	m.mutant.SetDuration(time.Since(start))
 
 	if err := m.mutant.Rollback(); err != nil {
 		// What should we do now?
 		log.Errorf("failed to restore mutation at %s - %s\n\t%v", m.mutant.Position(), m.mutant.Status(), err)
+	} else if m.confirmKills && m.mutant.Status() == mutator.Killed {
+		m.mutant.SetSuspectKill(m.runTests(rootDir, m.mutant.Pkg()) != mutator.Lived)
 	}
 
 	m.outCh <- m.mutant
 }
 
+// isDumped tells whether the mutant is the one to dump. It is selected by its
+// 'file:line:column' position, or by its fingerprint when more mutants share
+// the same position.
+func (m *mutantExecutor) isDumped() bool {
+	if m.dumpMutant == "" {
+		return false
+	}
+	pos := m.mutant.Position()
+
+	return m.dumpMutant == fmt.Sprintf("%s:%d:%d", pos.Filename, pos.Line, pos.Column) ||
+		m.dumpMutant == mutator.NewFingerprint(pos, m.mutant.Type().String())
+}
+
+// dump writes the mutated source of the mutant, as it is in the working
+// directory before the rollback, to the dump output. Without an output, the
+// source is logged.
+func (m *mutantExecutor) dump() {
+	pos := m.mutant.Position()
+	src, err := os.ReadFile(filepath.Join(m.mutant.Workdir(), pos.Filename))
+	if err != nil {
+		log.Errorf("failed to dump mutation at %s\n\t%v\n", pos, err)
+
+		return
+	}
+	if m.dumpMutantOut == "" {
+		log.Infof("mutated source of %s at %s:\n%s", m.mutant.Type(), pos, src)
+
+		return
+	}
+	if err := os.WriteFile(m.dumpMutantOut, src, 0600); err != nil {
+		log.Errorf("failed to dump mutation at %s\n\t%v\n", pos, err)
+	}
+}
+
+// packageNotFoundErrors are the messages of the go command when it can't
+// resolve the package to test, which is different from a build failure of the
+// package itself.
+var packageNotFoundErrors = []string{
+	"no required module provides package",
+	"cannot find package",
+	"is not in std",
+	"matched no packages",
+}
+
 func (m *mutantExecutor) runTests(rootDir, pkg string) mutator.Status {
+	status, out := m.runTestsOn(rootDir, pkg)
+	if m.integrationMode || !isPackageNotFound(out) {
+		return status
+	}
+	// The package path resolved during discovery is wrong, so the tests are
+	// run again in the directory of the mutant.
+	dir := "./" + filepath.ToSlash(filepath.Dir(m.mutant.Position().Filename))
+	log.Warnf("package %s not found, running the tests in %s\n", pkg, dir)
+	status, _ = m.runTestsOn(rootDir, dir)
+
+	return status
+}
+
+// goCacheDir is the directory of the go build cache of a worker, when the
+// cache is isolated.
+const goCacheDir = ".gocache"
+
+func (m *mutantExecutor) runTestsOn(rootDir, path string) (mutator.Status, []byte) {
 	ctx, cancel := context.WithTimeout(context.Background(), m.testExecutionTime)
 	defer cancel()
 
-	cmd := m.execContext(ctx, "go", m.getTestArgs(pkg)...)
+	cmd := m.execContext(ctx, "go", m.getTestArgs(path)...)
 	cmd.Dir = m.mutant.Workdir()
 	if m.integrationMode {
 		cmd.Dir = rootDir
 	}
 	cmd.Env = append(cmd.Env, os.Environ()...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GOTMPDIR=%s", m.wdDealer.WorkDir()))
+	if m.isolateGoCache {
+		// The cache is kept in the working directory of the worker, so it is
+		// reused by all the mutants the worker tests. The leading dot makes
+		// the go command ignore it when matching the packages.
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOCACHE=%s", filepath.Join(rootDir, goCacheDir)))
+	}
+	if m.buildCacheDir != "" {
+		// The cache is shared by all the workers and warmed up by the
+		// coverage, so the unchanged packages are not built again.
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOCACHE=%s", m.buildCacheDir))
+	}
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdout := &bytes.Buffer{}
+	if m.testJSON {
+		cmd.Stdout = stdout
+	}
 
 	rel, err := run(cmd)
 	defer rel()
 
 	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-		return mutator.TimedOut
+		return mutator.TimedOut, stderr.Bytes()
+	}
+	if m.testJSON {
+		if status, ok := testJSONStatus(stdout.Bytes()); ok {
+			return status, stderr.Bytes()
+		}
 	}
 	var exitErr *exec.ExitError
 	if errors.As(err, &exitErr) {
-		return getTestFailedStatus(exitErr.ExitCode())
+		return getTestFailedStatus(exitErr.ExitCode()), stderr.Bytes()
+	}
+
+	return mutator.Lived, stderr.Bytes()
+}
+
+func isPackageNotFound(out []byte) bool {
+	for _, e := range packageNotFoundErrors {
+		if bytes.Contains(out, []byte(e)) {
+			return true
+		}
 	}
 
-	return mutator.Lived
+	return false
 }
 
-func (m *mutantExecutor) getTestArgs(pkg string) []string {
+func (m *mutantExecutor) getTestArgs(path string) []string {
 	args := []string{"test"}
 	if m.buildTags != "" {
 		args = append(args, "-tags", m.buildTags)
@@ -226,12 +382,14 @@ func (m *mutantExecutor) getTestArgs(pkg string) []string {
 	// from hanging forever.
 	args = append(args, "-timeout", (2*time.Second + m.testExecutionTime).String())
 	args = append(args, "-failfast")
+	if m.testJSON {
+		args = append(args, "-json")
+	}
 
 	if m.testCPU != 0 {
 		args = append(args, fmt.Sprintf("-cpu %d", m.testCPU))
 	}
 
-	path := pkg
 	if m.integrationMode {
 		path = "./..."
 	}
@@ -254,13 +412,15 @@ func run(cmd *exec.Cmd) (func(), error) {
 	}, nil
 }
 
+// getTestFailedStatus maps the exit code of a failed go test to the status of
+// the mutant. Any exit code other than the build failure means that the tests
+// didn't pass, like a panic killing the process with a signal, so the mutant
+// is KILLED.
 func getTestFailedStatus(exitCode int) mutator.Status {
 	switch exitCode {
-	case 1:
-		return mutator.Killed
 	case 2:
 		return mutator.NotViable
 	default:
-		return mutator.Lived
+		return mutator.Killed
 	}
 }
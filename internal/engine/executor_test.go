@@ -18,9 +18,16 @@ package engine_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -29,6 +36,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/coverage"
 	"github.com/go-gremlins/gremlins/internal/engine"
 	"github.com/go-gremlins/gremlins/internal/engine/workerpool"
 	"github.com/go-gremlins/gremlins/internal/gomodule"
@@ -116,10 +124,238 @@ func TestApplyAndRollback(t *testing.T) {
 			t.Errorf("expected rollback not to be called")
 		}
 	})
+
+	t.Run("skips the mutant if apply leaves the source unchanged", func(t *testing.T) {
+		wdDealer := newWdDealerStub(t)
+		tmpDir, _ := wdDealer.Get("")
+		mod := gomodule.GoModule{
+			Name:       "example.com",
+			Root:       tmpDir,
+			CallingDir: ".",
+		}
+		mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout, engine.WithExecContext(fakeExecCommandSuccess))
+		mut := &mutantStub{
+			status:    mutator.Runnable,
+			mutType:   mutator.ConditionalsBoundary,
+			pkg:       "example.com",
+			unchanged: true,
+		}
+		outCh := make(chan mutator.Mutator, 1)
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		executor := mjd.NewExecutor(mut, outCh, &wg)
+		w := &workerpool.Worker{
+			Name: "test",
+			ID:   1,
+		}
+
+		executor.Start(w)
+
+		wg.Wait()
+
+		got := <-outCh
+		if got.Status() != mutator.Skipped {
+			t.Errorf("expected the mutant to be %s, got %s", mutator.Skipped, got.Status())
+		}
+		if mut.rollbackCalled {
+			t.Errorf("expected rollback not to be called")
+		}
+	})
+}
+
+func TestDumpMutant(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\tif a > 2 {\n\t\ta++\n\t}\n}\n"
+	testCases := []struct {
+		name       string
+		dumpMutant string
+		want       string
+	}{
+		{
+			name:       "it dumps the mutant at the position",
+			dumpMutant: "main.go:5:7",
+			want:       "package main\n\nfunc main() {\n\ta := 1\n\tif a >= 2 {\n\t\ta++\n\t}\n}\n",
+		},
+		{
+			name:       "it dumps the mutant with the fingerprint",
+			dumpMutant: "main.go:5:7:CONDITIONALS_BOUNDARY",
+			want:       "package main\n\nfunc main() {\n\ta := 1\n\tif a >= 2 {\n\t\ta++\n\t}\n}\n",
+		},
+		{
+			name:       "it doesn't dump the other mutants",
+			dumpMutant: "main.go:4:7",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rootDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(rootDir, "main.go"), []byte(src), 0600); err != nil {
+				t.Fatal(err)
+			}
+			output := filepath.Join(t.TempDir(), "mutant.go")
+			viperSet(map[string]any{
+				configuration.UnleashDryRunKey:        false,
+				configuration.UnleashDumpMutantKey:    tc.dumpMutant,
+				configuration.UnleashDumpMutantOutKey: output,
+			})
+			defer viperReset()
+
+			set := token.NewFileSet()
+			f, err := parser.ParseFile(set, "main.go", src, parser.ParseComments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var node *ast.BinaryExpr
+			ast.Inspect(f, func(n ast.Node) bool {
+				if n, ok := n.(*ast.BinaryExpr); ok {
+					node = n
+				}
+
+				return true
+			})
+			tn, _ := engine.NewTokenNode(node)
+			mut := engine.NewTokenMutant("example.com", set, f, tn)
+			mut.SetType(mutator.ConditionalsBoundary)
+			mut.SetStatus(mutator.Runnable)
+
+			wdDealer := &dealerStub{t: t, fnGet: func(_ string) (string, error) {
+				return rootDir, nil
+			}}
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       rootDir,
+				CallingDir: ".",
+			}
+			mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout, engine.WithExecContext(fakeExecCommandSuccess))
+			outCh := make(chan mutator.Mutator, 1)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+			executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+			wg.Wait()
+			<-outCh
+
+			got, err := os.ReadFile(output)
+			if tc.want == "" {
+				if !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("expected the mutant not to be dumped, got %q", got)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(string(got), tc.want) {
+				t.Errorf(cmp.Diff(tc.want, string(got)))
+			}
+			restored, _ := os.ReadFile(filepath.Join(rootDir, "main.go"))
+			if string(restored) != src {
+				t.Errorf("expected the source to be rolled back, got %q", restored)
+			}
+		})
+	}
+}
+
+func TestMutantDuration(t *testing.T) {
+	testCases := []struct {
+		name         string
+		mutantStatus mutator.Status
+		wantDuration bool
+	}{
+		{
+			name:         "it records the duration of the tests run",
+			mutantStatus: mutator.Runnable,
+			wantDuration: true,
+		},
+		{
+			name:         "it doesn't record the duration if tests are not run",
+			mutantStatus: mutator.NotCovered,
+			wantDuration: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			wdDealer := newWdDealerStub(t)
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout, engine.WithExecContext(fakeExecCommandSuccess))
+			mut := &mutantStub{
+				status:  tc.mutantStatus,
+				mutType: mutator.ConditionalsBoundary,
+				pkg:     "example.com",
+			}
+			outCh := make(chan mutator.Mutator)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+			w := &workerpool.Worker{
+				Name: "test",
+				ID:   1,
+			}
+			go func() {
+				<-outCh
+				close(outCh)
+			}()
+
+			executor.Start(w)
+
+			wg.Wait()
+
+			if tc.wantDuration && mut.Duration() <= 0 {
+				t.Errorf("expected duration to be recorded, got %s", mut.Duration())
+			}
+			if !tc.wantDuration && mut.Duration() != 0 {
+				t.Errorf("expected duration not to be recorded, got %s", mut.Duration())
+			}
+		})
+	}
 }
 
 type execContext = func(ctx context.Context, name string, args ...string) *exec.Cmd
 
+func TestDryRunDoesNotCreateWorkdirs(t *testing.T) {
+	viperSet(map[string]any{configuration.UnleashDryRunKey: true})
+	defer viperReset()
+	var gets int
+	wdDealer := &dealerStub{t: t, fnGet: func(_ string) (string, error) {
+		gets++
+
+		return t.TempDir(), nil
+	}}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout, engine.WithExecContext(fakeExecCommandSuccess))
+
+	for _, status := range []mutator.Status{mutator.Runnable, mutator.NotCovered} {
+		mut := &mutantStub{
+			status:  status,
+			mutType: mutator.ConditionalsBoundary,
+			pkg:     "example.com",
+		}
+		outCh := make(chan mutator.Mutator, 1)
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		mjd.NewExecutor(mut, outCh, &wg).Start(&workerpool.Worker{Name: "test", ID: 1})
+		wg.Wait()
+
+		if got := <-outCh; got.Status() != status || mut.applyCalled {
+			t.Errorf("expected %s mutant not to be tested, got %s", status, got.Status())
+		}
+	}
+
+	if gets != 0 {
+		t.Errorf("expected no worker directories to be created, got %d", gets)
+	}
+}
+
 func TestMutatorTestExecution(t *testing.T) {
 	testCases := []struct {
 		testResult    execContext
@@ -133,6 +369,12 @@ func TestMutatorTestExecution(t *testing.T) {
 			mutantStatus:  mutator.NotCovered,
 			wantMutStatus: mutator.NotCovered,
 		},
+		{
+			name:          "it skips NO TESTS",
+			testResult:    fakeExecCommandSuccess,
+			mutantStatus:  mutator.NoTests,
+			wantMutStatus: mutator.NoTests,
+		},
 		{
 			name:          "if tests pass then mutation is LIVED",
 			testResult:    fakeExecCommandSuccess,
@@ -151,6 +393,18 @@ func TestMutatorTestExecution(t *testing.T) {
 			mutantStatus:  mutator.Runnable,
 			wantMutStatus: mutator.NotViable,
 		},
+		{
+			name:          "if tests exit with an unexpected code then mutation is KILLED",
+			testResult:    fakeExecCommandExitCode(3),
+			mutantStatus:  mutator.Runnable,
+			wantMutStatus: mutator.Killed,
+		},
+		{
+			name:          "if tests are interrupted then mutation is KILLED",
+			testResult:    fakeExecCommandExitCode(130),
+			mutantStatus:  mutator.Runnable,
+			wantMutStatus: mutator.Killed,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -199,7 +453,7 @@ func TestMutatorTestExecution(t *testing.T) {
 				t.Errorf("expected mutation to be %v, but got: %v", tc.wantMutStatus, got.Status())
 			}
 
-			if tc.mutantStatus != mutator.NotCovered {
+			if tc.mutantStatus != mutator.NotCovered && tc.mutantStatus != mutator.NoTests {
 				goTmpDirEnv := fmt.Sprintf("GOTMPDIR=%s", wdDealer.WorkDir())
 				actualGoTmpDir := ""
 				for _, v := range holder.cmd.Env {
@@ -217,6 +471,400 @@ func TestMutatorTestExecution(t *testing.T) {
 	}
 }
 
+func TestConfirmKills(t *testing.T) {
+	testCases := []struct {
+		name          string
+		baseline      execContext
+		confirmKills  bool
+		wantSuspect   bool
+		wantTestsRuns int
+	}{
+		{
+			name:          "it flags the kill as suspect if the baseline fails",
+			baseline:      fakeExecCommandTestsFailure,
+			confirmKills:  true,
+			wantSuspect:   true,
+			wantTestsRuns: 2,
+		},
+		{
+			name:          "it doesn't flag the kill if the baseline passes",
+			baseline:      fakeExecCommandSuccess,
+			confirmKills:  true,
+			wantTestsRuns: 2,
+		},
+		{
+			name:          "it doesn't run the baseline by default",
+			baseline:      fakeExecCommandTestsFailure,
+			wantTestsRuns: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashDryRunKey:       false,
+				configuration.UnleashConfirmKillsKey: tc.confirmKills,
+			})
+			defer viperReset()
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			var runs int
+			m := sync.Mutex{}
+			fakeCmd := func(ctx context.Context, command string, args ...string) *exec.Cmd {
+				m.Lock()
+				defer m.Unlock()
+				runs++
+				if runs == 1 {
+					return fakeExecCommandTestsFailure(ctx, command, args...)
+				}
+
+				return tc.baseline(ctx, command, args...)
+			}
+			mjd := engine.NewExecutorDealer(mod, newWdDealerStub(t), expectedTimeout, engine.WithExecContext(fakeCmd))
+			mut := &mutantStub{
+				status:  mutator.Runnable,
+				mutType: mutator.ConditionalsBoundary,
+				pkg:     "example.com",
+			}
+			outCh := make(chan mutator.Mutator, 1)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+
+			executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+			wg.Wait()
+			got := <-outCh
+
+			if got.Status() != mutator.Killed {
+				t.Errorf("expected mutation to be %v, but got: %v", mutator.Killed, got.Status())
+			}
+			if got.SuspectKill() != tc.wantSuspect {
+				t.Errorf("expected the kill to be suspect %t, got %t", tc.wantSuspect, got.SuspectKill())
+			}
+			if runs != tc.wantTestsRuns {
+				t.Errorf("expected the tests to run %d times, got %d", tc.wantTestsRuns, runs)
+			}
+			if !mut.rollbackCalled {
+				t.Error("expected the mutation to be rolled back")
+			}
+		})
+	}
+}
+
+func TestNotViableRetries(t *testing.T) {
+	testCases := []struct {
+		name          string
+		retries       int
+		wantStatus    mutator.Status
+		wantTestsRuns int
+	}{
+		{
+			name:          "it builds again a NOT VIABLE mutant",
+			retries:       2,
+			wantStatus:    mutator.Killed,
+			wantTestsRuns: 2,
+		},
+		{
+			name:          "it doesn't build again by default",
+			wantStatus:    mutator.NotViable,
+			wantTestsRuns: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashDryRunKey:           false,
+				configuration.UnleashNotViableRetriesKey: tc.retries,
+			})
+			defer viperReset()
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			var runs int
+			m := sync.Mutex{}
+			// The build fails the first time only.
+			fakeCmd := func(ctx context.Context, command string, args ...string) *exec.Cmd {
+				m.Lock()
+				defer m.Unlock()
+				runs++
+				if runs == 1 {
+					return fakeExecCommandBuildFailure(ctx, command, args...)
+				}
+
+				return fakeExecCommandTestsFailure(ctx, command, args...)
+			}
+			mjd := engine.NewExecutorDealer(mod, newWdDealerStub(t), expectedTimeout, engine.WithExecContext(fakeCmd))
+			mut := &mutantStub{
+				status:  mutator.Runnable,
+				mutType: mutator.ConditionalsBoundary,
+				pkg:     "example.com",
+			}
+			outCh := make(chan mutator.Mutator, 1)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+
+			executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+			wg.Wait()
+			got := <-outCh
+
+			if got.Status() != tc.wantStatus {
+				t.Errorf("expected mutation to be %v, but got: %v", tc.wantStatus, got.Status())
+			}
+			if runs != tc.wantTestsRuns {
+				t.Errorf("expected the tests to run %d times, got %d", tc.wantTestsRuns, runs)
+			}
+		})
+	}
+}
+
+func TestMutatorTestExecutionWithTestJSON(t *testing.T) {
+	testCases := []struct {
+		name          string
+		stream        string
+		exitCode      int
+		wantMutStatus mutator.Status
+	}{
+		{
+			name:          "if tests pass then mutation is LIVED",
+			stream:        "pass.json",
+			exitCode:      0,
+			wantMutStatus: mutator.Lived,
+		},
+		{
+			name:          "if a test fails then mutation is KILLED",
+			stream:        "fail.json",
+			exitCode:      1,
+			wantMutStatus: mutator.Killed,
+		},
+		{
+			name:          "if build fails then mutation is NOT VIABLE",
+			stream:        "build_fail.json",
+			exitCode:      1,
+			wantMutStatus: mutator.NotViable,
+		},
+		{
+			name:          "if build fails on older Go then mutation is NOT VIABLE",
+			stream:        "build_fail_legacy.json",
+			exitCode:      1,
+			wantMutStatus: mutator.NotViable,
+		},
+		{
+			name:          "if the test binary times out then mutation is TIMED OUT",
+			stream:        "timeout.json",
+			exitCode:      1,
+			wantMutStatus: mutator.TimedOut,
+		},
+		{
+			name:          "if there are no events then the exit code is used",
+			stream:        "",
+			exitCode:      2,
+			wantMutStatus: mutator.NotViable,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashDryRunKey:   false,
+				configuration.UnleashTestJSONKey: true,
+			})
+			defer viperReset()
+			stream := ""
+			if tc.stream != "" {
+				var err error
+				stream, err = filepath.Abs(filepath.Join("testdata", "testjson", tc.stream))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			wdDealer := newWdDealerStub(t)
+			holder := &commandHolder{}
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout,
+				engine.WithExecContext(fakeExecCommandWithHolder(holder, fakeExecCommandTestJSON(stream, tc.exitCode))),
+			)
+			mut := &mutantStub{
+				status:  mutator.Runnable,
+				mutType: mutator.ConditionalsBoundary,
+				pkg:     "example.com",
+			}
+			outCh := make(chan mutator.Mutator, 1)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+			executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+			wg.Wait()
+			got := <-outCh
+
+			if got.Status() != tc.wantMutStatus {
+				t.Errorf("expected mutation to be %v, but got: %v", tc.wantMutStatus, got.Status())
+			}
+			if !slices.Contains(holder.args, "-json") {
+				t.Errorf("expected the tests to run with -json, got %v", holder.args)
+			}
+		})
+	}
+}
+
+func TestMutatorRunIsolatesGoCache(t *testing.T) {
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:         false,
+		configuration.UnleashIsolateGoCacheKey: true,
+	})
+	defer viperReset()
+	wdDealer := newWdDealerStub(t)
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+
+	var goCaches []string
+	for i := 1; i <= 2; i++ {
+		holder := &commandHolder{}
+		mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout,
+			engine.WithExecContext(fakeExecCommandSuccessWithHolder(holder)))
+		mut := &mutantStub{
+			status:  mutator.Runnable,
+			mutType: mutator.ConditionalsBoundary,
+			pkg:     "example.com",
+		}
+		outCh := make(chan mutator.Mutator, 1)
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		executor := mjd.NewExecutor(mut, outCh, &wg)
+		executor.Start(&workerpool.Worker{Name: "test", ID: i})
+		wg.Wait()
+		<-outCh
+
+		goCache := ""
+		for _, v := range holder.cmd.Env {
+			if strings.HasPrefix(v, "GOCACHE=") {
+				goCache = strings.TrimPrefix(v, "GOCACHE=")
+			}
+		}
+		if goCache == "" {
+			t.Fatalf("expected worker %d to set GOCACHE", i)
+		}
+		goCaches = append(goCaches, goCache)
+	}
+
+	if goCaches[0] == goCaches[1] {
+		t.Errorf("expected each worker to have its own GOCACHE, got %s for both", goCaches[0])
+	}
+}
+
+func TestMutatorRunSharesBuildCacheDir(t *testing.T) {
+	cacheDir := t.TempDir()
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey:        false,
+		configuration.UnleashBuildCacheDirKey: cacheDir,
+	})
+	defer viperReset()
+	wdDealer := newWdDealerStub(t)
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+
+	for i := 1; i <= 2; i++ {
+		holder := &commandHolder{}
+		mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout,
+			engine.WithExecContext(fakeExecCommandSuccessWithHolder(holder)))
+		mut := &mutantStub{
+			status:  mutator.Runnable,
+			mutType: mutator.ConditionalsBoundary,
+			pkg:     "example.com",
+		}
+		outCh := make(chan mutator.Mutator, 1)
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		executor := mjd.NewExecutor(mut, outCh, &wg)
+		executor.Start(&workerpool.Worker{Name: "test", ID: i})
+		wg.Wait()
+		<-outCh
+
+		goCache := ""
+		for _, v := range holder.cmd.Env {
+			if strings.HasPrefix(v, "GOCACHE=") {
+				goCache = strings.TrimPrefix(v, "GOCACHE=")
+			}
+		}
+		if goCache != cacheDir {
+			t.Errorf("expected worker %d to use the GOCACHE %s, got %q", i, cacheDir, goCache)
+		}
+	}
+}
+
+func TestCoverageAndMutantTestsUseTheSameTags(t *testing.T) {
+	const tags = "tag1,tag2"
+	viperSet(map[string]any{
+		configuration.UnleashDryRunKey: false,
+		configuration.UnleashTagsKey:   tags,
+	})
+	defer viperReset()
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+
+	var coverageArgs [][]string
+	fakeCoverageCmd := func(command string, args ...string) *exec.Cmd {
+		coverageArgs = append(coverageArgs, args)
+		cs := []string{"-test.run=TestCoverageProcessSuccess", "--", command}
+
+		return getCmd(context.Background(), append(cs, args...))
+	}
+	_, _ = coverage.NewWithCmd(fakeCoverageCmd, t.TempDir(), mod).Run()
+
+	holder := &commandHolder{}
+	mjd := engine.NewExecutorDealer(mod, newWdDealerStub(t), expectedTimeout,
+		engine.WithExecContext(fakeExecCommandSuccessWithHolder(holder)))
+	mut := &mutantStub{
+		status:  mutator.Runnable,
+		mutType: mutator.ConditionalsBoundary,
+		pkg:     "example.com",
+	}
+	outCh := make(chan mutator.Mutator, 1)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	mjd.NewExecutor(mut, outCh, &wg).Start(&workerpool.Worker{Name: "test", ID: 1})
+	wg.Wait()
+	<-outCh
+
+	if len(coverageArgs) != 2 {
+		t.Fatalf("expected the coverage to run two commands, got %d", len(coverageArgs))
+	}
+	gotCoverageTags := tagsArg(coverageArgs[1])
+	gotMutantTags := tagsArg(holder.args)
+	if gotCoverageTags != tags || gotMutantTags != tags {
+		t.Errorf("expected coverage and mutant tests to use tags %q, got %q and %q", tags, gotCoverageTags, gotMutantTags)
+	}
+}
+
+func tagsArg(args []string) string {
+	for i, a := range args {
+		if a == "-tags" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
+
 const expectedTimeout = 10 * time.Second
 
 type commandHolder struct {
@@ -442,6 +1090,82 @@ func TestProcessBuildFailure(_ *testing.T) {
 	os.Exit(2) // skipcq: RVV-A0003
 }
 
+func TestProcessExitCode(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	code, _ := strconv.Atoi(os.Getenv("GO_TEST_EXIT_CODE"))
+	os.Exit(code) // skipcq: RVV-A0003
+}
+
+func TestProcessTestJSON(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	if stream := os.Getenv("GO_TEST_JSON_STREAM"); stream != "" {
+		out, _ := os.ReadFile(stream)
+		_, _ = os.Stdout.Write(out)
+	}
+	code, _ := strconv.Atoi(os.Getenv("GO_TEST_EXIT_CODE"))
+	os.Exit(code) // skipcq: RVV-A0003
+}
+
+func TestProcessPackageNotFound(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	if path := os.Args[len(os.Args)-1]; strings.HasPrefix(path, "./") {
+		os.Exit(0) // skipcq: RVV-A0003
+	}
+	_, _ = fmt.Fprintln(os.Stderr, "no required module provides package example.com/wrong/pkg")
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
+func TestMutatorRunFallbackOnPackageNotFound(t *testing.T) {
+	viperSet(map[string]any{configuration.UnleashDryRunKey: false})
+	defer viperReset()
+	wdDealer := newWdDealerStub(t)
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	var paths []string
+	m := sync.Mutex{}
+	fakeCmd := func(ctx context.Context, command string, args ...string) *exec.Cmd {
+		m.Lock()
+		defer m.Unlock()
+		paths = append(paths, args[len(args)-1])
+		cs := []string{"-test.run=TestProcessPackageNotFound", "--", command}
+		cs = append(cs, args...)
+
+		return getCmd(ctx, cs)
+	}
+	mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout, engine.WithExecContext(fakeCmd))
+	mut := &mutantStub{
+		status:   mutator.Runnable,
+		mutType:  mutator.ConditionalsBoundary,
+		pkg:      "example.com/wrong/pkg",
+		position: token.Position{Filename: "path/file.go"},
+	}
+	outCh := make(chan mutator.Mutator, 1)
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	executor := mjd.NewExecutor(mut, outCh, &wg)
+
+	executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+	wg.Wait()
+	got := <-outCh
+
+	want := []string{"example.com/wrong/pkg", "./path"}
+	if !cmp.Equal(paths, want) {
+		t.Errorf(cmp.Diff(want, paths))
+	}
+	if got.Status() != mutator.Lived {
+		t.Errorf("expected mutation to be %v, but got: %v", mutator.Lived, got.Status())
+	}
+}
+
 func TestMutatorRunInTheCorrectFolder(t *testing.T) {
 	t.Run("mutation should run in the correct folder", func(t *testing.T) {
 		callingDir := "test/dir"
@@ -585,6 +1309,30 @@ func fakeExecCommandBuildFailure(ctx context.Context, command string, args ...st
 	return getCmd(ctx, cs)
 }
 
+func fakeExecCommandExitCode(code int) execContext {
+	return func(ctx context.Context, command string, args ...string) *exec.Cmd {
+		cs := []string{"-test.run=TestProcessExitCode", "--", command}
+		cs = append(cs, args...)
+		cmd := getCmd(ctx, cs)
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GO_TEST_EXIT_CODE=%d", code))
+
+		return cmd
+	}
+}
+
+func fakeExecCommandTestJSON(stream string, code int) execContext {
+	return func(ctx context.Context, command string, args ...string) *exec.Cmd {
+		cs := []string{"-test.run=TestProcessTestJSON", "--", command}
+		cs = append(cs, args...)
+		cmd := getCmd(ctx, cs)
+		cmd.Env = append(cmd.Env,
+			fmt.Sprintf("GO_TEST_JSON_STREAM=%s", stream),
+			fmt.Sprintf("GO_TEST_EXIT_CODE=%d", code))
+
+		return cmd
+	}
+}
+
 func getCmd(ctx context.Context, cs []string) *exec.Cmd {
 	// #nosec G204 - We are in tests, we don't care
 	cmd := exec.CommandContext(ctx, os.Args[0], cs...)
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// floatSignFlipSpecs builds the MutatorSpec of mutator.FloatSignFlip for a
+// float literal, which negates it and replaces it with zero.
+//
+//	3.14 -> (-3.14)
+//	3.14 -> 0.0
+//
+// The negated value is wrapped in parentheses, so that it can't merge with
+// an operator before it, as in x-(-3.14). A literal which already has a
+// unary minus becomes -(-3.14), which is its sign flip as well. The literals
+// with a zero value are skipped, since both the mutants would be equivalent.
+// Like in switchCaseValueSpecs, each mutant is reported at a distinct
+// position: the literal for the sign flip, and the end of the literal for
+// the zero.
+func floatSignFlipSpecs(node ast.Node) []MutatorSpec {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok || lit.Kind != token.FLOAT {
+		return nil
+	}
+	v, err := strconv.ParseFloat(lit.Value, 64)
+	if err != nil || v == 0 {
+		return nil
+	}
+
+	return []MutatorSpec{
+		floatSignFlipSpec(lit, "(-"+lit.Value+")", lit.Pos()),
+		floatSignFlipSpec(lit, "0.0", lit.End()),
+	}
+}
+
+func floatSignFlipSpec(lit *ast.BasicLit, value string, pos token.Pos) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.FloatSignFlip,
+		Matches: func(n ast.Node) bool {
+			return n == lit
+		},
+		Pos: func(ast.Node) token.Pos {
+			return pos
+		},
+		Mutate: func(ast.Node) func() {
+			actual := lit.Value
+			lit.Value = value
+
+			return func() {
+				lit.Value = actual
+			}
+		},
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestFloatSignFlip(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/float_sign_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.FloatSignFlip)
+
+	if len(mutants) != 4 {
+		t.Fatalf("expected 4 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		pi, pj := mutants[i].Position(), mutants[j].Position()
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+
+		return pi.Column < pj.Column
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it flips the sign of the literal",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 4, Column: 11},
+			want:   strings.Replace(src, "x - 1.5", "x - (-1.5)", 1),
+		},
+		{
+			name:   "it replaces the literal with zero",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 4, Column: 14},
+			want:   strings.Replace(src, "x - 1.5", "x - 0.0", 1),
+		},
+		{
+			name:   "it flips the sign of a negative literal",
+			mutant: mutants[2],
+			pos:    token.Position{Line: 5, Column: 14},
+			want:   strings.Replace(src, "y * -0.25", "y * -(-0.25)", 1),
+		},
+		{
+			name:   "it replaces a negative literal with zero",
+			mutant: mutants[3],
+			pos:    token.Position{Line: 5, Column: 18},
+			want:   strings.Replace(src, "y * -0.25", "y * -0.0", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestFloatSignFlipSkips(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+	}{
+		{
+			name: "it doesn't mutate a zero literal",
+			expr: "0.0",
+		},
+		{
+			name: "it doesn't mutate an integer literal",
+			expr: "float64(3)",
+		},
+		{
+			name: "it doesn't mutate an imaginary literal",
+			expr: "real(2.5i)",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc f() float64 {\n\treturn " + tc.expr + "\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.FloatSignFlip)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
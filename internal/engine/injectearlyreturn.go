@@ -0,0 +1,79 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// injectEarlyReturnSpec builds the MutatorSpec of mutator.InjectEarlyReturn,
+// which inserts a return statement at the start of the body of a function,
+// to check that the effects of the body are tested at all.
+//
+//	func f() { g() } -> func f() { return; g() }
+//
+// Like in continueToReturnSpecs, only the functions where a bare return is
+// valid are mutated. The bodies which are empty, or which already start with
+// a return statement, would produce an equivalent mutant, and they are
+// skipped.
+func injectEarlyReturnSpec() MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.InjectEarlyReturn,
+		Matches: func(node ast.Node) bool {
+			body := earlyReturnBody(node)
+			if body == nil || len(body.List) == 0 {
+				return false
+			}
+			_, isReturn := body.List[0].(*ast.ReturnStmt)
+
+			return !isReturn
+		},
+		Pos: func(node ast.Node) token.Pos {
+			return earlyReturnBody(node).List[0].Pos()
+		},
+		Mutate: func(node ast.Node) func() {
+			body := earlyReturnBody(node)
+			actual := body.List
+			ret := &ast.ReturnStmt{Return: actual[0].Pos()}
+			body.List = append([]ast.Stmt{ret}, actual...)
+
+			return func() {
+				body.List = actual
+			}
+		},
+	}
+}
+
+// earlyReturnBody returns the body of the function declaration or literal,
+// if a bare return is valid in it.
+func earlyReturnBody(node ast.Node) *ast.BlockStmt {
+	switch fn := node.(type) {
+	case *ast.FuncDecl:
+		if allowsBareReturn(fn.Type) {
+			return fn.Body
+		}
+	case *ast.FuncLit:
+		if allowsBareReturn(fn.Type) {
+			return fn.Body
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestInjectEarlyReturn(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/early_return_go")
+	src := string(fixture)
+	mutants := discoverMutants(t, src, mutator.InjectEarlyReturn)
+	if len(mutants) != 2 {
+		t.Fatalf("expected 2 mutants, got %d", len(mutants))
+	}
+	var got mutator.Mutator
+	for _, m := range mutants {
+		if m.Position().Line == 6 {
+			got = m
+		}
+	}
+	if got == nil || got.Position().Column != 2 {
+		t.Fatalf("expected a mutant at 6:2, got %v", mutants)
+	}
+
+	mutated := applyMutant(t, got, src)
+
+	want := strings.Replace(src, "\tcount++\n", "\treturn\n\tcount++\n", 1)
+	if !cmp.Equal(mutated, want) {
+		t.Errorf(cmp.Diff(want, mutated))
+	}
+}
+
+func TestInjectEarlyReturnSkips(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+		want int
+	}{
+		{
+			name: "it skips unnamed results",
+			src:  "package main\n\nfunc f() int {\n\tprintln()\n\n\treturn 0\n}\n",
+		},
+		{
+			name: "it mutates with named results",
+			src:  "package main\n\nfunc f() (n int) {\n\tn = 1\n\n\treturn n\n}\n",
+			want: 1,
+		},
+		{
+			name: "it skips empty bodies",
+			src:  "package main\n\nfunc f() {}\n",
+		},
+		{
+			name: "it skips bodies starting with a return",
+			src:  "package main\n\nfunc f() {\n\treturn\n}\n",
+		},
+		{
+			name: "it mutates a closure of a function with results",
+			src:  "package main\n\nfunc f() int {\n\tg := func() {\n\t\tprintln()\n\t}\n\tg()\n\n\treturn 0\n}\n",
+			want: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mutants := discoverMutants(t, tc.src, mutator.InjectEarlyReturn)
+
+			if len(mutants) != tc.want {
+				t.Errorf("expected %d mutants, got %d", tc.want, len(mutants))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// invertErrorCheckSpecs builds a MutatorSpec of mutator.InvertErrorCheck for
+// each error check done with errors.Is and errors.As in the body of a
+// function, which negates its result.
+//
+//	errors.Is(err, target) -> !errors.Is(err, target)
+//	errors.As(err, &target) -> !errors.As(err, &target)
+//
+// The function is the matched node, since the call can only be replaced by
+// its negation in its parent. The checks in the function literals of the
+// body belong to them, and they are left to their own specs.
+func invertErrorCheckSpecs(node ast.Node) []MutatorSpec {
+	var body *ast.BlockStmt
+	switch fn := node.(type) {
+	case *ast.FuncDecl:
+		body = fn.Body
+	case *ast.FuncLit:
+		body = fn.Body
+	default:
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	astutil.Apply(body, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if isErrorCheck(n) {
+				specs = append(specs, invertErrorCheckSpec(node, body, n))
+			}
+		}
+
+		return true
+	}, nil)
+
+	return specs
+}
+
+func invertErrorCheckSpec(fn ast.Node, body *ast.BlockStmt, call *ast.CallExpr) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.InvertErrorCheck,
+		Matches: func(n ast.Node) bool {
+			return n == fn
+		},
+		Pos: func(ast.Node) token.Pos {
+			return call.Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			negated := &ast.UnaryExpr{OpPos: call.Pos(), Op: token.NOT, X: call}
+			replaceExpr(body, call, negated)
+
+			return func() {
+				replaceExpr(body, negated, call)
+			}
+		},
+	}
+}
+
+// isErrorCheck tells whether the call is to errors.Is or errors.As, with
+// errors not shadowed by a local declaration.
+func isErrorCheck(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Is" && sel.Sel.Name != "As") {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+
+	return ok && pkg.Name == "errors" && pkg.Obj == nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestInvertErrorCheck(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/errors_is_go")
+
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "it negates errors.Is in an if",
+			src:  string(fixture),
+			want: "package main\n\nimport (\n\t\"errors\"\n\t\"io\"\n)\n\nfunc main() {\n\tvar err error\n\tif !errors.Is(err, io.EOF) {\n\t\treturn\n\t}\n}\n",
+		},
+		{
+			name: "it negates errors.As in a binary expression",
+			src:  "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\tvar target *MyErr\n\t_ = err != nil && errors.As(err, &target)\n}\n",
+			want: "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\tvar target *MyErr\n\t_ = err != nil && !errors.As(err, &target)\n}\n",
+		},
+		{
+			name: "it negates an already negated check",
+			src:  "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\t_ = !errors.Is(err, err)\n}\n",
+			want: "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\t_ = !!errors.Is(err, err)\n}\n",
+		},
+		{
+			name: "it negates errors.Is in a function literal",
+			src:  "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\tcheck := func() bool {\n\t\treturn errors.Is(err, err)\n\t}\n\t_ = check\n}\n",
+			want: "package main\n\nimport \"errors\"\n\nfunc main() {\n\tvar err error\n\tcheck := func() bool {\n\t\treturn !errors.Is(err, err)\n\t}\n\t_ = check\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, mutated := applySpecMutant(t, tc.src, mutator.InvertErrorCheck)
+
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestInvertErrorCheckSkipsNotMatching(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "other errors function",
+			src:  "package main\n\nimport \"errors\"\n\nfunc main() {\n\t_ = errors.New(\"e\")\n}\n",
+		},
+		{
+			name: "shadowed errors",
+			src:  "package main\n\ntype checker struct{}\n\nfunc (checker) Is(a, b error) bool { return a == b }\n\nfunc main() {\n\terrors := checker{}\n\t_ = errors.Is(nil, nil)\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mutants := discoverMutants(t, tc.src, mutator.InvertErrorCheck)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// minMaxSwapSpec builds the MutatorSpec of mutator.MinMaxSwap, which swaps
+// the min and max builtins.
+//
+//	min(a, b) -> max(a, b)
+//	max(a, b) -> min(a, b)
+//
+// A function named min or max declared in the file isn't mutated, while one
+// declared in another file of the package can't be told apart from the
+// builtin, and its mutants are most likely NOT VIABLE.
+func minMaxSwapSpec() MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.MinMaxSwap,
+		Matches: func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+
+			return ok && (isBuiltin(call.Fun, "min") || isBuiltin(call.Fun, "max"))
+		},
+		Pos: func(node ast.Node) token.Pos {
+			call, _ := node.(*ast.CallExpr)
+
+			return call.Fun.Pos()
+		},
+		Mutate: func(node ast.Node) func() {
+			call, _ := node.(*ast.CallExpr)
+			ident, _ := call.Fun.(*ast.Ident)
+			actual := ident.Name
+			ident.Name = "min"
+			if actual == "min" {
+				ident.Name = "max"
+			}
+
+			return func() {
+				ident.Name = actual
+			}
+		},
+	}
+}
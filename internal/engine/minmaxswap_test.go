@@ -0,0 +1,70 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestMinMaxSwap(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/min_max_go")
+
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "it swaps min with max",
+			src:  string(fixture),
+			want: "package main\n\nfunc main() {\n\ta, b := 1, 2\n\t_ = max(a, b)\n}\n",
+		},
+		{
+			name: "it swaps max with min",
+			src:  "package main\n\nfunc main() {\n\ta, b := 1, 2\n\t_ = max(a, b)\n}\n",
+			want: "package main\n\nfunc main() {\n\ta, b := 1, 2\n\t_ = min(a, b)\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, mutated := applySpecMutant(t, tc.src, mutator.MinMaxSwap)
+
+			if got.Position().Line != 5 || got.Position().Column != 6 {
+				t.Errorf("expected mutant at 5:6, got %s", got.Position())
+			}
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestMinMaxSwapSkipsUserDefined(t *testing.T) {
+	src := "package main\n\nfunc min(a, b int) int { return a }\n\nfunc main() {\n\t_ = min(1, 2)\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.MinMaxSwap)
+
+	if len(mutants) != 0 {
+		t.Errorf("expected no mutants, got %d", len(mutants))
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// nilCheckInvertSpec builds the MutatorSpec of mutator.NilCheckInvert, which
+// inverts the comparisons with nil. The checks of the errors are left to the
+// other mutators.
+//
+//	x == nil -> x != nil
+//	x != nil -> x == nil
+func nilCheckInvertSpec() MutatorSpec {
+	return MutatorSpec{
+		Type:    mutator.NilCheckInvert,
+		Matches: isNilCheck,
+		Pos: func(node ast.Node) token.Pos {
+			expr, _ := node.(*ast.BinaryExpr)
+
+			return expr.OpPos
+		},
+		Mutate: func(node ast.Node) func() {
+			expr, _ := node.(*ast.BinaryExpr)
+			actual := expr.Op
+			expr.Op = token.EQL
+			if actual == token.EQL {
+				expr.Op = token.NEQ
+			}
+
+			return func() {
+				expr.Op = actual
+			}
+		},
+	}
+}
+
+// isNilCheck checks if the node is the comparison with nil of something that
+// isn't an error named err.
+func isNilCheck(node ast.Node) bool {
+	expr, ok := node.(*ast.BinaryExpr)
+	if !ok || (expr.Op != token.EQL && expr.Op != token.NEQ) {
+		return false
+	}
+	operand := expr.X
+	switch {
+	case isBuiltin(expr.Y, "nil"):
+	case isBuiltin(expr.X, "nil"):
+		operand = expr.Y
+	default:
+		return false
+	}
+	ident, ok := operand.(*ast.Ident)
+
+	return !ok || ident.Name != "err"
+}
+
+// supersededByNilCheck tells if a mutant of the given mutator.Type on the
+// node is already produced by mutator.NilCheckInvert, so that the same nil
+// check isn't mutated twice when both the types are enabled.
+func supersededByNilCheck(mt mutator.Type, node ast.Node) bool {
+	if mt != mutator.ConditionalsNegation || !isNilCheck(node) {
+		return false
+	}
+
+	return configuration.Get[bool](configuration.MutantTypeEnabledKey(mutator.NilCheckInvert))
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestNilCheckInvert(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/nil_check_go")
+
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "it inverts an equality with nil",
+			src:  string(fixture),
+			want: "package main\n\nfunc main() {\n\tvar m map[string]int\n\tif m != nil {\n\t\tm = map[string]int{}\n\t}\n\t_ = m\n}\n",
+		},
+		{
+			name: "it inverts an inequality with nil on the left",
+			src:  "package main\n\nfunc main() {\n\tvar p *int\n\t_ = nil != p\n}\n",
+			want: "package main\n\nfunc main() {\n\tvar p *int\n\t_ = nil == p\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, got := applySpecMutant(t, tc.src, mutator.NilCheckInvert)
+
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestNilCheckInvertSkipsNotMatching(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "error check",
+			src:  "package main\n\nfunc main() {\n\tvar err error\n\t_ = err != nil\n}\n",
+		},
+		{
+			name: "comparison without nil",
+			src:  "package main\n\nfunc main() {\n\ta, b := 1, 2\n\t_ = a == b\n}\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mutants := discoverMutants(t, tc.src, mutator.NilCheckInvert)
+
+			if len(mutants) != 0 {
+				t.Errorf("expected no mutants, got %d", len(mutants))
+			}
+		})
+	}
+}
+
+func TestNilCheckInvertSupersedesConditionalsNegation(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/nil_check_go")
+	nilCheckKey := configuration.MutantTypeEnabledKey(mutator.NilCheckInvert)
+
+	testCases := []struct {
+		name     string
+		nilCheck bool
+		want     int
+	}{
+		{
+			name:     "nil checks are not negated when NIL_CHECK_INVERT is enabled",
+			nilCheck: true,
+			want:     0,
+		},
+		{
+			name:     "nil checks are negated when NIL_CHECK_INVERT is disabled",
+			nilCheck: false,
+			want:     1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := map[string]any{nilCheckKey: tc.nilCheck}
+			mutants := discoverMutantsWithConfig(t, string(fixture), mutator.ConditionalsNegation, cfg)
+
+			if len(mutants) != tc.want {
+				t.Errorf("expected %d %s mutants, got %d", tc.want, mutator.ConditionalsNegation, len(mutants))
+			}
+		})
+	}
+}
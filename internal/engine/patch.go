@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around the change.
+const diffContext = 3
+
+// unifiedDiff returns the unified diff between the original and the mutated
+// source of the named file.
+// A mutation changes a single contiguous part of the file, so the diff is made
+// of a single hunk, which goes from the first to the last changed line.
+func unifiedDiff(name string, orig, mutated []byte) string {
+	if bytes.Equal(orig, mutated) {
+		return ""
+	}
+	a, b := splitLines(orig), splitLines(mutated)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	start := max(prefix-diffContext, 0)
+	aEnd := min(len(a)-suffix+diffContext, len(a))
+	bEnd := min(len(b)-suffix+diffContext, len(b))
+
+	sb := &strings.Builder{}
+	_, _ = fmt.Fprintf(sb, "--- a/%s\n+++ b/%s\n", name, name)
+	_, _ = fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(start, aEnd-start), hunkRange(start, bEnd-start))
+	writeLines(sb, " ", a[start:prefix])
+	writeLines(sb, "-", a[prefix:len(a)-suffix])
+	writeLines(sb, "+", b[prefix:len(b)-suffix])
+	writeLines(sb, " ", a[len(a)-suffix:aEnd])
+
+	return sb.String()
+}
+
+func hunkRange(start, length int) string {
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+// splitLines splits the source in lines, keeping their line terminators.
+func splitLines(src []byte) []string {
+	lines := strings.SplitAfter(string(src), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+func writeLines(sb *strings.Builder, prefix string, lines []string) {
+	for _, l := range lines {
+		sb.WriteString(prefix)
+		sb.WriteString(l)
+		if !strings.HasSuffix(l, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
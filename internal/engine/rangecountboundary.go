@@ -0,0 +1,109 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// rangeCountBoundarySpecs builds the MutatorSpec of
+// mutator.RangeCountBoundary for a range over an integer, which changes the
+// count of the iterations by one.
+//
+//	for i := range n -> for i := range n + 1
+//	for i := range n -> for i := range n - 1
+//
+// Since there is no type information, only the range expressions that are
+// integers by their syntax are mutated: integer literals, len and cap calls,
+// and the arithmetic on them. The decrement is reported at the count and the
+// increment at the range keyword, so that they have distinct fingerprints.
+func rangeCountBoundarySpecs(node ast.Node) []MutatorSpec {
+	stmt, ok := node.(*ast.RangeStmt)
+	if !ok || !isIntCount(stmt.X) {
+		return nil
+	}
+
+	return []MutatorSpec{
+		rangeCountBoundarySpec(stmt, token.SUB, stmt.X.Pos()),
+		rangeCountBoundarySpec(stmt, token.ADD, stmt.Range),
+	}
+}
+
+func rangeCountBoundarySpec(stmt *ast.RangeStmt, op token.Token, pos token.Pos) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.RangeCountBoundary,
+		Matches: func(n ast.Node) bool {
+			return n == stmt
+		},
+		Pos: func(ast.Node) token.Pos {
+			return pos
+		},
+		Mutate: func(ast.Node) func() {
+			actual := stmt.X
+			if lit, ok := actual.(*ast.BasicLit); ok {
+				if v, err := strconv.ParseInt(lit.Value, 0, 64); err == nil {
+					value := lit.Value
+					if op == token.ADD {
+						v++
+					} else {
+						v--
+					}
+					lit.Value = strconv.FormatInt(v, 10)
+
+					return func() {
+						lit.Value = value
+					}
+				}
+			}
+			stmt.X = &ast.BinaryExpr{
+				X:  actual,
+				Op: op,
+				Y:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+			}
+
+			return func() {
+				stmt.X = actual
+			}
+		},
+	}
+}
+
+// isIntCount tells if the expression is an integer by its syntax.
+func isIntCount(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.INT
+	case *ast.ParenExpr:
+		return isIntCount(e.X)
+	case *ast.CallExpr:
+		return isBuiltin(e.Fun, "len") || isBuiltin(e.Fun, "cap")
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+			token.AND, token.OR, token.XOR, token.AND_NOT, token.SHL, token.SHR:
+			return isIntCount(e.X) || isIntCount(e.Y)
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
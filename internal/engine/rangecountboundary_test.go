@@ -0,0 +1,125 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestRangeCountBoundary(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/range_count_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.RangeCountBoundary)
+
+	if len(mutants) != 2 {
+		t.Fatalf("expected 2 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		return mutants[i].Position().Column < mutants[j].Position().Column
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		column int
+		want   string
+	}{
+		{
+			name:   "it increments the count",
+			mutant: mutants[0],
+			column: 6,
+			want:   strings.Replace(src, "range 5", "range 6", 1),
+		},
+		{
+			name:   "it decrements the count",
+			mutant: mutants[1],
+			column: 12,
+			want:   strings.Replace(src, "range 5", "range 4", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mutant.Position().Line != 5 || tc.mutant.Position().Column != tc.column {
+				t.Errorf("expected mutant at 5:%d, got %s", tc.column, tc.mutant.Position())
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestRangeCountBoundaryExpressions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		count string
+		want  []string
+	}{
+		{
+			name:  "it mutates a len call",
+			count: "len(s)",
+			want:  []string{"len(s) + 1", "len(s) - 1"},
+		},
+		{
+			name:  "it mutates the arithmetic on integers",
+			count: "len(s) / 2",
+			want:  []string{"len(s)/2 + 1", "len(s)/2 - 1"},
+		},
+		{
+			name:  "it skips a slice",
+			count: "s",
+		},
+		{
+			name:  "it skips an identifier",
+			count: "n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc f(s []int, n int) {\n\tfor range " + tc.count + " {\n\t}\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.RangeCountBoundary)
+
+			if len(mutants) != len(tc.want) {
+				t.Fatalf("expected %d mutants, got %d", len(tc.want), len(mutants))
+			}
+			var got []string
+			for _, m := range mutants {
+				got = append(got, applyMutant(t, m, src))
+			}
+			sort.Strings(got)
+			var want []string
+			for _, w := range tc.want {
+				want = append(want, strings.Replace(src, "range "+tc.count, "range "+w, 1))
+			}
+			sort.Strings(want)
+			if !cmp.Equal(got, want) {
+				t.Errorf(cmp.Diff(want, got))
+			}
+		})
+	}
+}
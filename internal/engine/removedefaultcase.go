@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// removeDefaultCaseSpecs builds the MutatorSpec of mutator.RemoveDefaultCase
+// for a switch or type switch statement with a default clause, which drops
+// the clause, so that nothing runs when no case matches.
+//
+//	switch x { case 1: a(); default: b() } -> switch x { case 1: a() }
+//
+// The mutant is reported at the default keyword. The body keeps its
+// original list of clauses aside, since the mutation replaces it.
+func removeDefaultCaseSpecs(node ast.Node) []MutatorSpec {
+	var body *ast.BlockStmt
+	switch stmt := node.(type) {
+	case *ast.SwitchStmt:
+		body = stmt.Body
+	case *ast.TypeSwitchStmt:
+		body = stmt.Body
+	default:
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	idx := -1
+	for i, s := range body.List {
+		if clause, ok := s.(*ast.CaseClause); ok && clause.List == nil {
+			idx = i
+
+			break
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+	list := body.List
+
+	return []MutatorSpec{{
+		Type: mutator.RemoveDefaultCase,
+		Matches: func(n ast.Node) bool {
+			return n == node
+		},
+		Pos: func(ast.Node) token.Pos {
+			return list[idx].Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			mutated := make([]ast.Stmt, 0, len(list)-1)
+			mutated = append(mutated, list[:idx]...)
+			body.List = append(mutated, list[idx+1:]...)
+
+			return func() {
+				body.List = list
+			}
+		},
+	}}
+}
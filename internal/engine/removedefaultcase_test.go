@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestRemoveDefaultCase(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/default_case_go")
+	src := string(fixture)
+
+	mutant, got := applySpecMutant(t, src, mutator.RemoveDefaultCase)
+
+	// The printer keeps the lines of the dropped clause apart.
+	want := strings.Replace(src, "\tdefault:\n\t\ts = \"other\"\n", "\n", 1)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+	wantPos := token.Position{Line: 8, Column: 2}
+	if pos := mutant.Position(); pos.Line != wantPos.Line || pos.Column != wantPos.Column {
+		t.Errorf("expected mutant at %d:%d, got %s", wantPos.Line, wantPos.Column, pos)
+	}
+
+	// The default clause is restored in the AST, so the mutant can be
+	// applied again.
+	if again := applyMutant(t, mutant, src); !cmp.Equal(again, want) {
+		t.Errorf(cmp.Diff(want, again))
+	}
+}
+
+func TestRemoveDefaultCaseTypeSwitch(t *testing.T) {
+	src := "package main\n\nfunc f(v any) int {\n\tn := 0\n\tswitch v.(type) {\n\tcase int:\n\t\tn = 1\n\tdefault:\n\t\tn = 2\n\t}\n\n\treturn n\n}\n"
+
+	_, got := applySpecMutant(t, src, mutator.RemoveDefaultCase)
+
+	want := "package main\n\nfunc f(v any) int {\n\tn := 0\n\tswitch v.(type) {\n\tcase int:\n\t\tn = 1\n\n\t}\n\n\treturn n\n}\n"
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestRemoveDefaultCaseSkipsSwitchWithoutDefault(t *testing.T) {
+	src := "package main\n\nfunc f(x int) int {\n\tswitch x {\n\tcase 1:\n\t\tx = 2\n\t}\n\n\treturn x\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.RemoveDefaultCase)
+
+	if len(mutants) != 0 {
+		t.Errorf("expected no mutants, got %d", len(mutants))
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// removeElseSpecs builds the MutatorSpec of mutator.RemoveElse for an if
+// statement with an else branch, which drops the branch, so that nothing runs
+// when the condition is false.
+//
+//	if c { a() } else { b() } -> if c { a() }
+//
+// An else if chain is dropped as a whole. The mutant is reported at the
+// start of the else branch, which is kept aside since the mutation removes
+// it from the node.
+func removeElseSpecs(node ast.Node) []MutatorSpec {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok || stmt.Else == nil {
+		return nil
+	}
+	els := stmt.Else
+
+	return []MutatorSpec{{
+		Type: mutator.RemoveElse,
+		Matches: func(n ast.Node) bool {
+			return n == stmt
+		},
+		Pos: func(ast.Node) token.Pos {
+			return els.Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			stmt.Else = nil
+
+			return func() {
+				stmt.Else = els
+			}
+		},
+	}}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestRemoveElse(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/else_go")
+	src := string(fixture)
+
+	mutant, got := applySpecMutant(t, src, mutator.RemoveElse)
+
+	// The printer keeps the lines of the following statements apart.
+	want := strings.Replace(src, " else {\n\t\ts = \"positive\"\n\t}", "\n", 1)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+	wantPos := token.Position{Line: 7, Column: 9}
+	if pos := mutant.Position(); pos.Line != wantPos.Line || pos.Column != wantPos.Column {
+		t.Errorf("expected mutant at %d:%d, got %s", wantPos.Line, wantPos.Column, pos)
+	}
+
+	// The else branch is restored in the AST, so the mutant can be applied
+	// again.
+	if again := applyMutant(t, mutant, src); !cmp.Equal(again, want) {
+		t.Errorf(cmp.Diff(want, again))
+	}
+}
+
+func TestRemoveElseChain(t *testing.T) {
+	src := "package main\n\nfunc f(x int) int {\n\tif x < 0 {\n\t\tx = -x\n\t} else if x > 10 {\n\t\tx = 10\n\t} else {\n\t\tx++\n\t}\n\treturn x\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.RemoveElse)
+
+	if len(mutants) != 2 {
+		t.Fatalf("expected 2 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		return mutants[i].Position().Line < mutants[j].Position().Line
+	})
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "it drops the whole else if chain",
+			want: "package main\n\nfunc f(x int) int {\n\tif x < 0 {\n\t\tx = -x\n\t}\n\n\treturn x\n}\n",
+		},
+		{
+			name: "it drops the last else of the chain",
+			want: "package main\n\nfunc f(x int) int {\n\tif x < 0 {\n\t\tx = -x\n\t} else if x > 10 {\n\t\tx = 10\n\t}\n\n\treturn x\n}\n",
+		},
+	}
+	for i, tc := range testCases {
+		tc := tc
+		m := mutants[i]
+		t.Run(tc.name, func(t *testing.T) {
+			if got := applyMutant(t, m, src); !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestRemoveElseSkipsIfWithoutElse(t *testing.T) {
+	src := "package main\n\nfunc f(x int) int {\n\tif x < 0 {\n\t\treturn -x\n\t}\n\treturn x\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.RemoveElse)
+
+	if len(mutants) != 0 {
+		t.Errorf("expected no mutants, got %d", len(mutants))
+	}
+}
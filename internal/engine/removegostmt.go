@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// removeGoStmtSpecs builds a MutatorSpec of mutator.RemoveGoStmt for each go
+// statement of a list of statements, which runs its call synchronously.
+//
+//	go f(x) -> f(x)
+//
+// The node holding the list is the matched node, since the go statement is
+// replaced by a statement of another kind, and only its parent can do that.
+func removeGoStmtSpecs(node ast.Node) []MutatorSpec {
+	var list []ast.Stmt
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		list = n.List
+	case *ast.CaseClause:
+		list = n.Body
+	case *ast.CommClause:
+		list = n.Body
+	default:
+		return nil
+	}
+
+	var specs []MutatorSpec
+	for i, stmt := range list {
+		if goStmt, ok := stmt.(*ast.GoStmt); ok {
+			specs = append(specs, removeGoStmtSpec(node, list, i, goStmt))
+		}
+	}
+
+	return specs
+}
+
+func removeGoStmtSpec(parent ast.Node, list []ast.Stmt, i int, goStmt *ast.GoStmt) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.RemoveGoStmt,
+		Matches: func(n ast.Node) bool {
+			return n == parent
+		},
+		Pos: func(ast.Node) token.Pos {
+			return goStmt.Go
+		},
+		Mutate: func(ast.Node) func() {
+			list[i] = &ast.ExprStmt{X: goStmt.Call}
+
+			return func() {
+				list[i] = goStmt
+			}
+		},
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestRemoveGoStmt(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/go_stmt_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.RemoveGoStmt)
+
+	if len(mutants) != 2 {
+		t.Fatalf("expected 2 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		return mutants[i].Position().Line < mutants[j].Position().Line
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it runs the call synchronously",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 4, Column: 2},
+			want:   strings.Replace(src, "go worker(jobs, done)", "worker(jobs, done)", 1),
+		},
+		{
+			name:   "it runs a function literal synchronously in a clause",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 7, Column: 3},
+			want:   strings.Replace(src, "go func() {", "func() {", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// sliceBoundarySpecs builds the MutatorSpec of mutator.SliceBoundary for a
+// slice expression, which changes its low and high bounds by one.
+//
+//	a[1:3] -> a[0:3]
+//	a[1:3] -> a[2:3]
+//	a[1:3] -> a[1:2]
+//	a[1:3] -> a[1:4]
+//
+// A missing low bound is zero, so it is only incremented, and a missing high
+// bound is left alone, since the length of the operand is not known. A
+// literal bound is never decremented below zero, which doesn't build. Each
+// mutant is reported at a distinct position: the brackets for the increments,
+// and the bounds for the decrements.
+func sliceBoundarySpecs(node ast.Node) []MutatorSpec {
+	expr, ok := node.(*ast.SliceExpr)
+	if !ok {
+		return nil
+	}
+
+	result := []MutatorSpec{sliceBoundarySpec(expr, &expr.Low, token.ADD, expr.Lbrack)}
+	if expr.Low != nil && canDecrement(expr.Low) {
+		result = append(result, sliceBoundarySpec(expr, &expr.Low, token.SUB, expr.Low.Pos()))
+	}
+	if expr.High != nil {
+		if canDecrement(expr.High) {
+			result = append(result, sliceBoundarySpec(expr, &expr.High, token.SUB, expr.High.Pos()))
+		}
+		result = append(result, sliceBoundarySpec(expr, &expr.High, token.ADD, expr.Rbrack))
+	}
+
+	return result
+}
+
+func sliceBoundarySpec(expr *ast.SliceExpr, bound *ast.Expr, op token.Token, pos token.Pos) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.SliceBoundary,
+		Matches: func(n ast.Node) bool {
+			return n == expr
+		},
+		Pos: func(ast.Node) token.Pos {
+			return pos
+		},
+		Mutate: func(ast.Node) func() {
+			actual := *bound
+			if actual == nil {
+				*bound = &ast.BasicLit{Kind: token.INT, Value: "1"}
+
+				return func() {
+					*bound = nil
+				}
+			}
+			if lit, ok := actual.(*ast.BasicLit); ok {
+				if v, err := strconv.ParseInt(lit.Value, 0, 64); err == nil {
+					value := lit.Value
+					if op == token.ADD {
+						v++
+					} else {
+						v--
+					}
+					lit.Value = strconv.FormatInt(v, 10)
+
+					return func() {
+						lit.Value = value
+					}
+				}
+			}
+			*bound = &ast.BinaryExpr{
+				X:  actual,
+				Op: op,
+				Y:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+			}
+
+			return func() {
+				*bound = actual
+			}
+		},
+	}
+}
+
+// canDecrement tells if the bound can be decremented, which is not the case
+// of a zero literal.
+func canDecrement(bound ast.Expr) bool {
+	lit, ok := bound.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return true
+	}
+	v, err := strconv.ParseInt(lit.Value, 0, 64)
+
+	return err != nil || v > 0
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestSliceBoundary(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/slice_bounds_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.SliceBoundary)
+
+	if len(mutants) != 7 {
+		t.Fatalf("expected 7 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		pi, pj := mutants[i].Position(), mutants[j].Position()
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+
+		return pi.Column < pj.Column
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it increments the low bound",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 5, Column: 7},
+			want:   strings.Replace(src, "a[1:3]", "a[2:3]", 1),
+		},
+		{
+			name:   "it decrements the low bound",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 5, Column: 8},
+			want:   strings.Replace(src, "a[1:3]", "a[0:3]", 1),
+		},
+		{
+			name:   "it decrements the high bound",
+			mutant: mutants[2],
+			pos:    token.Position{Line: 5, Column: 10},
+			want:   strings.Replace(src, "a[1:3]", "a[1:2]", 1),
+		},
+		{
+			name:   "it increments the high bound",
+			mutant: mutants[3],
+			pos:    token.Position{Line: 5, Column: 11},
+			want:   strings.Replace(src, "a[1:3]", "a[1:4]", 1),
+		},
+		{
+			name:   "it increments the missing low bound from zero",
+			mutant: mutants[4],
+			pos:    token.Position{Line: 6, Column: 7},
+			want:   strings.Replace(src, "a[:3]", "a[1:3]", 1),
+		},
+		{
+			name:   "it decrements the high bound without the low bound",
+			mutant: mutants[5],
+			pos:    token.Position{Line: 6, Column: 9},
+			want:   strings.Replace(src, "a[:3]", "a[:2]", 1),
+		},
+		{
+			name:   "it increments the high bound without the low bound",
+			mutant: mutants[6],
+			pos:    token.Position{Line: 6, Column: 10},
+			want:   strings.Replace(src, "a[:3]", "a[:4]", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestSliceBoundaryExpressions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice string
+		want  []string
+	}{
+		{
+			name:  "it mutates the expressions of the bounds",
+			slice: "a[i:n]",
+			want:  []string{"a[i+1 : n]", "a[i-1 : n]", "a[i : n-1]", "a[i : n+1]"},
+		},
+		{
+			name:  "it doesn't decrement a zero bound",
+			slice: "a[0:]",
+			want:  []string{"a[1:]"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc f(a []int, i, n int) {\n\t_ = " + tc.slice + "\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.SliceBoundary)
+
+			if len(mutants) != len(tc.want) {
+				t.Fatalf("expected %d mutants, got %d", len(tc.want), len(mutants))
+			}
+			var got []string
+			for _, m := range mutants {
+				got = append(got, applyMutant(t, m, src))
+			}
+			sort.Strings(got)
+			var want []string
+			for _, w := range tc.want {
+				want = append(want, strings.Replace(src, tc.slice, w, 1))
+			}
+			sort.Strings(want)
+			if !cmp.Equal(got, want) {
+				t.Errorf(cmp.Diff(want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"slices"
+	"sync"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// MutatorSpec declares a mutation that can be discovered and applied by the
+// Engine.
+//
+//	Type is the mutator.Type of the mutants produced by the spec.
+//	Matches is the predicate that tells if the spec applies to an ast.Node.
+//	Pos returns the token.Pos of the mutant for a matching ast.Node.
+//	Mutate applies the mutation on the ast.Node in place and returns the
+//	function that restores the ast.Node to its original state.
+//
+// Since the AST is shared among mutants, Mutate must only modify the matched
+// ast.Node and its children, and restore must put them back exactly as they
+// were.
+type MutatorSpec struct {
+	Matches func(node ast.Node) bool
+	Pos     func(node ast.Node) token.Pos
+	Mutate  func(node ast.Node) (restore func())
+	Type    mutator.Type
+}
+
+// ErrInvalidMutatorSpec is returned when registering a MutatorSpec without
+// all its functions set.
+var ErrInvalidMutatorSpec = errors.New("invalid mutator spec, Matches, Pos and Mutate must be set")
+
+var specs []MutatorSpec
+var specsMutex sync.RWMutex
+
+// elementSpecs are the builders of the MutatorSpec that apply to the
+// elements of a node, rather than to the node as a whole. Since a
+// MutatorSpec produces a single mutant for each matching node, a node with
+// many elements to mutate gets a MutatorSpec for each of them.
+var elementSpecs = []func(node ast.Node) []MutatorSpec{
+	dropStructFieldSpecs,
+	continueToReturnSpecs,
+	rangeCountBoundarySpecs,
+	dropLogicalOperandSpecs,
+	invertErrorCheckSpecs,
+	sliceBoundarySpecs,
+	switchCaseValueSpecs,
+	floatSignFlipSpecs,
+	contextReplaceSpecs,
+	removeGoStmtSpecs,
+	removeElseSpecs,
+	collectionElementSpecs,
+	strictnessToggleSpecs,
+	removeDefaultCaseSpecs,
+}
+
+func init() {
+	for _, mt := range mutator.Types {
+		if _, ok := tokenMutations[mt]; ok {
+			specs = append(specs, tokenSpec(mt))
+		}
+	}
+	specs = append(specs, dropAppendArgSpec(), nilCheckInvertSpec(), minMaxSwapSpec(), injectEarlyReturnSpec())
+}
+
+// RegisterMutatorSpec adds a MutatorSpec to the ones used by the Engine
+// during the discovery of the mutants.
+// It must be called before the initialisation of the Engine.
+func RegisterMutatorSpec(spec MutatorSpec) error {
+	if spec.Matches == nil || spec.Pos == nil || spec.Mutate == nil {
+		return ErrInvalidMutatorSpec
+	}
+	specsMutex.Lock()
+	defer specsMutex.Unlock()
+	specs = append(specs, spec)
+
+	return nil
+}
+
+// MutatorSpecs returns the currently registered MutatorSpec.
+func MutatorSpecs() []MutatorSpec {
+	specsMutex.RLock()
+	defer specsMutex.RUnlock()
+
+	return slices.Clone(specs)
+}
+
+// tokenSpec builds the MutatorSpec of the mutator.Type applied on tokens,
+// using the TokenMutantType and tokenMutations tables.
+func tokenSpec(mt mutator.Type) MutatorSpec {
+	return MutatorSpec{
+		Type: mt,
+		Matches: func(node ast.Node) bool {
+			n, ok := NewTokenNode(node)
+			if !ok {
+				return false
+			}
+
+			return slices.Contains(TokenMutantType[n.Tok()], mt)
+		},
+		Pos: func(node ast.Node) token.Pos {
+			n, _ := NewTokenNode(node)
+
+			return n.TokPos
+		},
+		Mutate: func(node ast.Node) func() {
+			n, _ := NewTokenNode(node)
+			actual := n.Tok()
+			n.SetTok(tokenMutations[mt][actual])
+
+			return func() {
+				n.SetTok(actual)
+			}
+		},
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/coverage"
+	"github.com/go-gremlins/gremlins/internal/engine"
+	"github.com/go-gremlins/gremlins/internal/gomodule"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestCustomMutatorSpec(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 42\n\tb := 43\n}\n"
+	want := "package main\n\nfunc main() {\n\ta := 0\n\tb := 43\n}\n"
+
+	mt := mutator.NewType("REMOVE_ANSWER")
+	err := engine.RegisterMutatorSpec(engine.MutatorSpec{
+		Type: mt,
+		Matches: func(node ast.Node) bool {
+			lit, ok := node.(*ast.BasicLit)
+
+			return ok && lit.Value == "42"
+		},
+		Pos: func(node ast.Node) token.Pos {
+			return node.Pos()
+		},
+		Mutate: func(node ast.Node) func() {
+			lit, _ := node.(*ast.BasicLit)
+			lit.Value = "0"
+
+			return func() {
+				lit.Value = "42"
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, mutated := applySpecMutant(t, src, mt)
+
+	if got.Status() != mutator.Runnable {
+		t.Errorf("expected mutant to be %s, got %s", mutator.Runnable, got.Status())
+	}
+	if got.Position().Line != 4 || got.Position().Column != 7 {
+		t.Errorf("expected mutant at 4:7, got %s", got.Position())
+	}
+	if !cmp.Equal(mutated, want) {
+		t.Errorf(cmp.Diff(want, mutated))
+	}
+}
+
+func TestRegisterInvalidMutatorSpec(t *testing.T) {
+	err := engine.RegisterMutatorSpec(engine.MutatorSpec{Type: mutator.ArithmeticBase})
+
+	if !errors.Is(err, engine.ErrInvalidMutatorSpec) {
+		t.Errorf("expected %v, got %v", engine.ErrInvalidMutatorSpec, err)
+	}
+}
+
+// discoverMutants returns the mutants of the given mutator.Type found in the
+// src file, which is considered completely covered.
+func discoverMutants(t *testing.T, src string, mt mutator.Type) []mutator.Mutator {
+	t.Helper()
+
+	return discoverMutantsWithConfig(t, src, mt, nil)
+}
+
+// discoverMutantsWithConfig is like discoverMutants, but it sets the given
+// configuration before the discovery.
+func discoverMutantsWithConfig(t *testing.T, src string, mt mutator.Type, cfg map[string]any) []mutator.Mutator {
+	t.Helper()
+	set := map[string]any{
+		configuration.UnleashDryRunKey:         true,
+		configuration.MutantTypeEnabledKey(mt): true,
+	}
+	for k, v := range cfg {
+		set[k] = v
+	}
+	viperSet(set)
+	defer viperReset()
+
+	sys := fstest.MapFS{
+		"main.go":      {Data: []byte(src)},
+		"main_test.go": {Data: []byte("package main")},
+	}
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		Root:       ".",
+		CallingDir: ".",
+	}
+	codeData := engine.CodeData{
+		Cov: coverage.Profile{"main.go": {{StartLine: 1, EndLine: strings.Count(src, "\n") + 1, StartCol: 1, EndCol: 1}}},
+	}
+	mut := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(sys))
+	res := mut.Run(context.Background())
+
+	var found []mutator.Mutator
+	for _, m := range res.Mutants {
+		if m.Type() == mt {
+			found = append(found, m)
+		}
+	}
+
+	return found
+}
+
+// applySpecMutant discovers the only mutant of the given mutator.Type in the
+// src file, then it applies and rolls it back. It returns the mutant and the
+// mutated source.
+func applySpecMutant(t *testing.T, src string, mt mutator.Type) (mutator.Mutator, string) {
+	t.Helper()
+	found := discoverMutants(t, src, mt)
+	if len(found) != 1 {
+		t.Fatalf("expected 1 %s mutant, got %d", mt, len(found))
+	}
+	got := found[0]
+
+	return got, applyMutant(t, got, src)
+}
+
+// applyMutant applies the mutant on the src file and rolls it back. It
+// returns the mutated source.
+func applyMutant(t *testing.T, got mutator.Mutator, src string) string {
+	t.Helper()
+	workdir := t.TempDir()
+	filePath := filepath.Join(workdir, "main.go")
+	if err := os.WriteFile(filePath, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got.SetWorkdir(workdir)
+
+	if err := got.Apply(); err != nil {
+		t.Fatal(err)
+	}
+	mutated, _ := os.ReadFile(filePath)
+
+	if err := got.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	rolledBack, _ := os.ReadFile(filePath)
+	if !cmp.Equal(string(rolledBack), src) {
+		t.Errorf(cmp.Diff(src, string(rolledBack)))
+	}
+
+	return string(mutated)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// strictnessToggleSpecs builds the MutatorSpec of mutator.StrictnessToggle
+// for a function whose comparisons are both strict and non-strict. One
+// mutant makes all of them strict and the other one makes all of them
+// non-strict, so that the tests tell if the asymmetry is intended.
+//
+//	if i < n && n <= max  -> if i < n && n < max
+//	if i < n && n <= max  -> if i <= n && n <= max
+//
+// A mutant toggling a single comparison would be the same as the one of
+// mutator.ConditionalsBoundary at the same position, so it is left to it
+// when enabled.
+func strictnessToggleSpecs(node ast.Node) []MutatorSpec {
+	fn, ok := node.(*ast.FuncDecl)
+	if !ok || fn.Body == nil {
+		return nil
+	}
+	var strict, nonStrict []*ast.BinaryExpr
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		expr, ok := n.(*ast.BinaryExpr)
+		if !ok {
+			return true
+		}
+		switch expr.Op {
+		case token.LSS, token.GTR:
+			strict = append(strict, expr)
+		case token.LEQ, token.GEQ:
+			nonStrict = append(nonStrict, expr)
+		}
+
+		return true
+	})
+	if len(strict) == 0 || len(nonStrict) == 0 {
+		return nil
+	}
+
+	var specs []MutatorSpec
+	for _, exprs := range [][]*ast.BinaryExpr{nonStrict, strict} {
+		if len(exprs) == 1 && boundaryApplies(exprs[0]) {
+			continue
+		}
+		specs = append(specs, strictnessToggleSpec(fn, exprs))
+	}
+
+	return specs
+}
+
+// boundaryApplies checks if mutator.ConditionalsBoundary produces a mutant
+// on the comparison.
+func boundaryApplies(expr *ast.BinaryExpr) bool {
+	return configuration.Get[bool](configuration.MutantTypeEnabledKey(mutator.ConditionalsBoundary)) &&
+		tokenTargeted(mutator.ConditionalsBoundary, expr)
+}
+
+func strictnessToggleSpec(fn *ast.FuncDecl, exprs []*ast.BinaryExpr) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.StrictnessToggle,
+		Matches: func(n ast.Node) bool {
+			return n == fn
+		},
+		Pos: func(ast.Node) token.Pos {
+			return exprs[0].OpPos
+		},
+		Mutate: func(ast.Node) func() {
+			actual := make([]token.Token, len(exprs))
+			for i, expr := range exprs {
+				actual[i] = expr.Op
+				expr.Op = tokenMutations[mutator.ConditionalsBoundary][expr.Op]
+			}
+
+			return func() {
+				for i, expr := range exprs {
+					expr.Op = actual[i]
+				}
+			}
+		},
+	}
+}
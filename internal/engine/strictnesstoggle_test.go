@@ -0,0 +1,125 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestStrictnessToggle(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/strictness_go")
+	src := string(fixture)
+
+	mutants := discoverMutantsWithConfig(t, src, mutator.StrictnessToggle, map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.ConditionalsBoundary): false,
+	})
+
+	if len(mutants) != 4 {
+		t.Fatalf("expected 4 mutants, got %d", len(mutants))
+	}
+	sortByPosition(mutants)
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it makes the comparisons strict",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 4, Column: 13},
+			want:   strings.Replace(src, "low <= i && i < high", "low < i && i < high", 1),
+		},
+		{
+			name:   "it makes the comparisons non-strict",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 4, Column: 23},
+			want:   strings.Replace(src, "low <= i && i < high", "low <= i && i <= high", 1),
+		},
+		{
+			name:   "it makes all the comparisons of the function non-strict",
+			mutant: mutants[2],
+			pos:    token.Position{Line: 8, Column: 7},
+			want:   strings.Replace(strings.Replace(src, "v < low", "v <= low", 1), "v > high {", "v >= high {", 1),
+		},
+		{
+			name:   "it makes all the comparisons of the function strict",
+			mutant: mutants[3],
+			pos:    token.Position{Line: 14, Column: 7},
+			want:   strings.Replace(src, "v >= high-1", "v > high-1", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestStrictnessToggleLeavesSingleComparisonsToBoundary(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/strictness_go")
+	src := string(fixture)
+
+	mutants := discoverMutantsWithConfig(t, src, mutator.StrictnessToggle, map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.ConditionalsBoundary): true,
+	})
+
+	if len(mutants) != 1 {
+		t.Fatalf("expected 1 mutant, got %d", len(mutants))
+	}
+	if pos := mutants[0].Position(); pos.Line != 8 || pos.Column != 7 {
+		t.Errorf("expected mutant at 8:7, got %s", pos)
+	}
+}
+
+func TestStrictnessToggleSkipsConsistentFunctions(t *testing.T) {
+	src := "package main\n\nfunc between(v, low, high int) bool {\n\treturn low < v && v < high\n}\n"
+
+	mutants := discoverMutants(t, src, mutator.StrictnessToggle)
+
+	if len(mutants) != 0 {
+		t.Errorf("expected no mutants, got %d", len(mutants))
+	}
+}
+
+func sortByPosition(mutants []mutator.Mutator) {
+	sort.Slice(mutants, func(i, j int) bool {
+		pi, pj := mutants[i].Position(), mutants[j].Position()
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+
+		return pi.Column < pj.Column
+	})
+}
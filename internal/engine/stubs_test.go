@@ -25,8 +25,10 @@ import (
 	"sync"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/engine"
 	"github.com/go-gremlins/gremlins/internal/engine/workerpool"
 	"github.com/go-gremlins/gremlins/internal/gomodule"
 	"github.com/go-gremlins/gremlins/internal/mutator"
@@ -51,6 +53,9 @@ func viperReset() {
 	for _, mt := range mutator.Types {
 		configuration.Set(configuration.MutantTypeEnabledKey(mt), true)
 	}
+	// INJECT_EARLY_RETURN mutates every function, so it is left to its own
+	// tests not to add a mutant to all the fixtures.
+	configuration.Set(configuration.MutantTypeEnabledKey(mutator.InjectEarlyReturn), false)
 	viperMutex.Unlock()
 }
 
@@ -60,6 +65,8 @@ func loadFixture(fixture, fromPackage string) (fstest.MapFS, gomodule.GoModule,
 	filename := filenameFromFixture(fixture)
 	mapFS := fstest.MapFS{
 		filename: {Data: src},
+		// The test file keeps the mutants of the fixture from being NO TESTS.
+		strings.TrimSuffix(filename, ".go") + "_test.go": {Data: []byte("package main")},
 	}
 
 	return mapFS, gomodule.GoModule{
@@ -116,13 +123,90 @@ func (j *executorDealerStub) NewExecutor(mut mutator.Mutator, outCh chan<- mutat
 	}
 }
 
+// livedDealerStub deals executors that make all the mutants LIVED.
+type livedDealerStub struct {
+	dealt int
+}
+
+func (d *livedDealerStub) NewExecutor(mut mutator.Mutator, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) workerpool.Executor {
+	d.dealt++
+	mut.SetStatus(mutator.Lived)
+
+	return &executorStub{
+		mut:   mut,
+		outCh: outCh,
+		wg:    wg,
+	}
+}
+
+// slowDealerStub deals executors that take the given time to test the
+// mutants, which are KILLED.
+type slowDealerStub struct {
+	delay time.Duration
+}
+
+func (d *slowDealerStub) NewExecutor(mut mutator.Mutator, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) workerpool.Executor {
+	mut.SetStatus(mutator.Killed)
+
+	return &executorStub{
+		mut:   mut,
+		outCh: outCh,
+		wg:    wg,
+		delay: d.delay,
+	}
+}
+
+// concurrencyDealerStub deals executors that keep track of the maximum
+// number of mutants tested at the same time, overall and by package.
+type concurrencyDealerStub struct {
+	mutex     sync.Mutex
+	active    map[string]int
+	maxActive map[string]int
+	total     int
+	maxTotal  int
+}
+
+func (d *concurrencyDealerStub) NewExecutor(mut mutator.Mutator, outCh chan<- mutator.Mutator, wg *sync.WaitGroup) workerpool.Executor {
+	return &concurrencyExecutorStub{
+		executorStub: executorStub{mut: mut, outCh: outCh, wg: wg, delay: 20 * time.Millisecond},
+		dealer:       d,
+	}
+}
+
+func (d *concurrencyDealerStub) add(pkg string, n int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.active[pkg] += n
+	d.maxActive[pkg] = max(d.maxActive[pkg], d.active[pkg])
+	d.total += n
+	d.maxTotal = max(d.maxTotal, d.total)
+}
+
+type concurrencyExecutorStub struct {
+	executorStub
+	dealer *concurrencyDealerStub
+}
+
+func (j *concurrencyExecutorStub) Start(w *workerpool.Worker) {
+	j.dealer.add(j.mut.Pkg(), 1)
+	time.Sleep(j.delay)
+	j.dealer.add(j.mut.Pkg(), -1)
+	j.delay = 0
+	j.executorStub.Start(w)
+}
+
 type executorStub struct {
 	mut   mutator.Mutator
 	outCh chan<- mutator.Mutator
 	wg    *sync.WaitGroup
+	delay time.Duration
 }
 
 func (j *executorStub) Start(_ *workerpool.Worker) {
+	time.Sleep(j.delay)
+	if j.delay > 0 {
+		j.mut.SetDuration(j.delay)
+	}
 	j.outCh <- j.mut
 	j.wg.Done()
 }
@@ -133,6 +217,12 @@ type mutantStub struct {
 	position       token.Position
 	status         mutator.Status
 	mutType        mutator.Type
+	duration       time.Duration
+	nodeKind       mutator.NodeKind
+	inInit         bool
+	function       string
+	suspect        bool
+	unchanged      bool
 	applyCalled    bool
 	rollbackCalled bool
 
@@ -180,6 +270,9 @@ func (m *mutantStub) Apply() error {
 	if m.hasApplyError {
 		return errors.New("test error")
 	}
+	if m.unchanged {
+		return engine.ErrSourceUnchanged
+	}
 
 	return nil
 }
@@ -189,3 +282,51 @@ func (m *mutantStub) Rollback() error {
 
 	return nil
 }
+
+func (m *mutantStub) Duration() time.Duration {
+	return m.duration
+}
+
+func (m *mutantStub) SetDuration(d time.Duration) {
+	m.duration = d
+}
+
+func (m *mutantStub) NodeKind() mutator.NodeKind {
+	return m.nodeKind
+}
+
+func (m *mutantStub) SetNodeKind(k mutator.NodeKind) {
+	m.nodeKind = k
+}
+
+func (m *mutantStub) InInit() bool {
+	return m.inInit
+}
+
+func (m *mutantStub) SetInInit(in bool) {
+	m.inInit = in
+}
+
+func (m *mutantStub) SuspectKill() bool {
+	return m.suspect
+}
+
+func (m *mutantStub) SetSuspectKill(suspect bool) {
+	m.suspect = suspect
+}
+
+func (m *mutantStub) Unchanged() bool {
+	return m.unchanged
+}
+
+func (m *mutantStub) Function() string {
+	return m.function
+}
+
+func (m *mutantStub) SetFunction(name string) {
+	m.function = name
+}
+
+func (*mutantStub) Diff() string {
+	return ""
+}
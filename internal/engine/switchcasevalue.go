@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// switchCaseValueSpecs builds the MutatorSpec of mutator.SwitchCaseValue for
+// a switch statement, which changes by one the integer literals of its case
+// clauses.
+//
+//	case 1: -> case 0:
+//	case 1: -> case 2:
+//
+// A value already used by another case of the switch is not produced, since
+// the duplicate case wouldn't build. Each mutant is reported at a distinct
+// position: the literal for the decrement, and the end of the literal for
+// the increment.
+func switchCaseValueSpecs(node ast.Node) []MutatorSpec {
+	stmt, ok := node.(*ast.SwitchStmt)
+	if !ok || stmt.Tag == nil {
+		return nil
+	}
+
+	var lits []*ast.BasicLit
+	values := make(map[int64]bool)
+	for _, s := range stmt.Body.List {
+		clause, ok := s.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, expr := range clause.List {
+			lit, v, ok := intCaseValue(expr)
+			if !ok {
+				continue
+			}
+			values[v] = true
+			if lit != nil {
+				lits = append(lits, lit)
+			}
+		}
+	}
+
+	var result []MutatorSpec
+	for _, lit := range lits {
+		v, _ := strconv.ParseInt(lit.Value, 0, 64)
+		if !values[v-1] {
+			result = append(result, switchCaseValueSpec(stmt, lit, v-1, lit.Pos()))
+		}
+		if !values[v+1] {
+			result = append(result, switchCaseValueSpec(stmt, lit, v+1, lit.End()))
+		}
+	}
+
+	return result
+}
+
+// intCaseValue returns the value of an integer literal case expression, and
+// the literal itself if it can be mutated. A negative literal is only taken
+// into account for the values already used by the switch.
+func intCaseValue(expr ast.Expr) (*ast.BasicLit, int64, bool) {
+	neg := false
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		neg = true
+		expr = u.X
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return nil, 0, false
+	}
+	v, err := strconv.ParseInt(lit.Value, 0, 64)
+	if err != nil {
+		return nil, 0, false
+	}
+	if neg {
+		return nil, -v, true
+	}
+
+	return lit, v, true
+}
+
+func switchCaseValueSpec(stmt *ast.SwitchStmt, lit *ast.BasicLit, v int64, pos token.Pos) MutatorSpec {
+	return MutatorSpec{
+		Type: mutator.SwitchCaseValue,
+		Matches: func(n ast.Node) bool {
+			return n == stmt
+		},
+		Pos: func(ast.Node) token.Pos {
+			return pos
+		},
+		Mutate: func(ast.Node) func() {
+			value := lit.Value
+			lit.Value = strconv.FormatInt(v, 10)
+
+			return func() {
+				lit.Value = value
+			}
+		},
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestSwitchCaseValue(t *testing.T) {
+	fixture, _ := os.ReadFile("testdata/fixtures/switch_case_go")
+	src := string(fixture)
+
+	mutants := discoverMutants(t, src, mutator.SwitchCaseValue)
+
+	if len(mutants) != 4 {
+		t.Fatalf("expected 4 mutants, got %d", len(mutants))
+	}
+	sort.Slice(mutants, func(i, j int) bool {
+		pi, pj := mutants[i].Position(), mutants[j].Position()
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+
+		return pi.Column < pj.Column
+	})
+	testCases := []struct {
+		name   string
+		mutant mutator.Mutator
+		pos    token.Position
+		want   string
+	}{
+		{
+			name:   "it decrements the case value",
+			mutant: mutants[0],
+			pos:    token.Position{Line: 6, Column: 7},
+			want:   strings.Replace(src, "case 1:", "case 0:", 1),
+		},
+		{
+			name:   "it increments the case value",
+			mutant: mutants[1],
+			pos:    token.Position{Line: 6, Column: 8},
+			want:   strings.Replace(src, "case 1:", "case 2:", 1),
+		},
+		{
+			name:   "it decrements the first value of a list",
+			mutant: mutants[2],
+			pos:    token.Position{Line: 8, Column: 7},
+			want:   strings.Replace(src, "case 3, 4:", "case 2, 4:", 1),
+		},
+		{
+			name:   "it increments the last value of a list",
+			mutant: mutants[3],
+			pos:    token.Position{Line: 8, Column: 11},
+			want:   strings.Replace(src, "case 3, 4:", "case 3, 5:", 1),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos := tc.mutant.Position()
+			if pos.Line != tc.pos.Line || pos.Column != tc.pos.Column {
+				t.Errorf("expected mutant at %d:%d, got %s", tc.pos.Line, tc.pos.Column, pos)
+			}
+			mutated := applyMutant(t, tc.mutant, src)
+			if !cmp.Equal(mutated, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, mutated))
+			}
+		})
+	}
+}
+
+func TestSwitchCaseValueSkips(t *testing.T) {
+	testCases := []struct {
+		name string
+		stmt string
+		want []string
+	}{
+		{
+			name: "it doesn't mutate a switch without tag",
+			stmt: "switch {\n\tcase n == 1:\n\t}",
+		},
+		{
+			name: "it doesn't mutate non integer values",
+			stmt: "switch s {\n\tcase \"a\", x:\n\t}",
+		},
+		{
+			name: "it doesn't produce the values of the other cases",
+			stmt: "switch n {\n\tcase -1, 0, 1:\n\tcase 2:\n\t}",
+			want: []string{"switch n {\n\tcase -1, 0, 1:\n\tcase 3:\n\t}"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := "package main\n\nfunc f(n int, s, x string) {\n\t" + tc.stmt + "\n}\n"
+
+			mutants := discoverMutants(t, src, mutator.SwitchCaseValue)
+
+			if len(mutants) != len(tc.want) {
+				t.Fatalf("expected %d mutants, got %d", len(tc.want), len(mutants))
+			}
+			for i, m := range mutants {
+				want := strings.Replace(src, tc.stmt, tc.want[i], 1)
+				if got := applyMutant(t, m, src); !cmp.Equal(got, want) {
+					t.Errorf(cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// testEvent is the subset of the events of go test -json needed to tell the
+// outcome of a test run.
+type testEvent struct {
+	Action string
+	Test   string
+	Output string
+}
+
+// testJSONStatus maps the events of go test -json to the status of the
+// mutant. It returns false when the events don't tell the outcome, for example
+// when the go command fails before running the tests, so the caller can fall
+// back to the exit code.
+//
+// A build failure is reported by the build-fail action on recent versions of
+// Go, and only by the output of the package on the older ones.
+func testJSONStatus(out []byte) (mutator.Status, bool) {
+	var buildFailed, timedOut, failed, passed bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		switch e.Action {
+		case "build-fail":
+			buildFailed = true
+		case "output":
+			if strings.Contains(e.Output, "[build failed]") || strings.Contains(e.Output, "[setup failed]") {
+				buildFailed = true
+			}
+			if strings.HasPrefix(e.Output, "panic: test timed out") {
+				timedOut = true
+			}
+		case "fail":
+			failed = true
+		case "pass":
+			passed = true
+		}
+	}
+
+	switch {
+	case buildFailed:
+		return mutator.NotViable, true
+	case timedOut:
+		return mutator.TimedOut, true
+	case failed:
+		return mutator.Killed, true
+	case passed:
+		return mutator.Lived, true
+	default:
+		return mutator.Runnable, false
+	}
+}
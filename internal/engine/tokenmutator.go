@@ -18,17 +18,22 @@ package engine
 
 import (
 	"bytes"
+	"errors"
 	"go/ast"
 	"go/printer"
 	"go/token"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
+	"github.com/go-gremlins/gremlins/internal/log"
 	"github.com/go-gremlins/gremlins/internal/mutator"
 )
 
-// TokenMutator is a mutator.Mutator of a token.Token.
+// TokenMutator is a mutator.Mutator of a token.Token or, more in general,
+// of an ast.Node described by a MutatorSpec.
 //
 // Since the AST is shared among mutants, it is important to avoid that more
 // than one mutation is applied to the same file before writing it. For this
@@ -37,19 +42,50 @@ import (
 // the file it is operating on. Once the file is written and the token is
 // rolled back, the lock is released.
 // Keeping a lock per file instead of a lock per TokenMutator allows to apply
-// mutations on different files in parallel.
+// mutations on different files in parallel. The number of files written in
+// parallel can be further limited by a writeLimiter shared among the mutants.
 type TokenMutator struct {
-	pkg         string
-	fs          *token.FileSet
-	file        *ast.File
-	tokenNode   *NodeToken
-	workDir     string
-	origFile    []byte
-	status      mutator.Status
-	mutantType  mutator.Type
-	actualToken token.Token
+	pkg        string
+	fs         *token.FileSet
+	file       *ast.File
+	tokenNode  *NodeToken
+	node       ast.Node
+	spec       *MutatorSpec
+	workDir    string
+	origFile   []byte
+	status     mutator.Status
+	mutantType mutator.Type
+	nodeKind   mutator.NodeKind
+	inInit     bool
+	function   string
+	suspect    bool
+	duration   time.Duration
+	diff       string
+	mutated    []byte
+	writes     writeLimiter
+
+	// writeRetries is the number of times a failed write is retried, to
+	// survive transient errors, ex. a file locked by an antivirus.
+	writeRetries int
+
+	// fileMode is the permission of the written files. When not set, the
+	// existing files keep their own.
+	fileMode fs.FileMode
+
+	// selfCheck makes Apply verify that the mutation changes the printed
+	// source, which is otherwise a bug of the mutator.
+	selfCheck bool
+	unchanged bool
+
+	// livedDiff makes Rollback keep the diff of the mutation if the
+	// TokenMutator LIVED, the only one which is reported.
+	livedDiff bool
 }
 
+// ErrSourceUnchanged is returned by Apply when the self-check finds that the
+// mutation leaves the source unchanged.
+var ErrSourceUnchanged = errors.New("the mutation doesn't change the source")
+
 // NewTokenMutant initialises a TokenMutator.
 func NewTokenMutant(pkg string, set *token.FileSet, file *ast.File, node *NodeToken) *TokenMutator {
 	return &TokenMutator{
@@ -60,6 +96,19 @@ func NewTokenMutant(pkg string, set *token.FileSet, file *ast.File, node *NodeTo
 	}
 }
 
+// NewSpecMutant initialises a TokenMutator that applies the mutation
+// described by the MutatorSpec on the given ast.Node.
+func NewSpecMutant(pkg string, set *token.FileSet, file *ast.File, node ast.Node, spec MutatorSpec) *TokenMutator {
+	return &TokenMutator{
+		pkg:        pkg,
+		fs:         set,
+		file:       file,
+		node:       node,
+		spec:       &spec,
+		mutantType: spec.Type,
+	}
+}
+
 // Type returns the mutator.Type of the mutant.Mutator.
 func (m *TokenMutator) Type() mutator.Type {
 	return m.mutantType
@@ -80,13 +129,79 @@ func (m *TokenMutator) SetStatus(s mutator.Status) {
 	m.status = s
 }
 
+// Duration returns the time it took to run the tests on the mutant.Mutator.
+func (m *TokenMutator) Duration() time.Duration {
+	return m.duration
+}
+
+// SetDuration sets the time it took to run the tests on the mutant.Mutator.
+func (m *TokenMutator) SetDuration(d time.Duration) {
+	m.duration = d
+}
+
+// NodeKind returns the mutator.NodeKind of the position mutated by the TokenMutator.
+func (m *TokenMutator) NodeKind() mutator.NodeKind {
+	return m.nodeKind
+}
+
+// SetNodeKind sets the mutator.NodeKind of the position mutated by the TokenMutator.
+func (m *TokenMutator) SetNodeKind(k mutator.NodeKind) {
+	m.nodeKind = k
+}
+
+// InInit tells whether the TokenMutator is in an init function.
+func (m *TokenMutator) InInit() bool {
+	return m.inInit
+}
+
+// SetInInit sets whether the TokenMutator is in an init function.
+func (m *TokenMutator) SetInInit(in bool) {
+	m.inInit = in
+}
+
+// SuspectKill tells whether the kill of the TokenMutator is suspect.
+func (m *TokenMutator) SuspectKill() bool {
+	return m.suspect
+}
+
+// SetSuspectKill sets whether the kill of the TokenMutator is suspect.
+func (m *TokenMutator) SetSuspectKill(suspect bool) {
+	m.suspect = suspect
+}
+
+// Function returns the name of the function enclosing the TokenMutator.
+func (m *TokenMutator) Function() string {
+	return m.function
+}
+
+// SetFunction sets the name of the function enclosing the TokenMutator.
+func (m *TokenMutator) SetFunction(name string) {
+	m.function = name
+}
+
+// Unchanged tells whether the self-check found that the TokenMutator
+// doesn't change the source.
+func (m *TokenMutator) Unchanged() bool {
+	return m.unchanged
+}
+
+// Diff returns the unified diff of the change made by the last Apply. It is
+// only computed for a LIVED TokenMutator, when the lived diffs are enabled.
+func (m *TokenMutator) Diff() string {
+	return m.diff
+}
+
 // Position returns the token.Position where the TokenMutator resides.
 func (m *TokenMutator) Position() token.Position {
-	return m.fs.Position(m.tokenNode.TokPos)
+	return m.fs.Position(m.Pos())
 }
 
 // Pos returns the token.Pos where the TokenMutator resides.
 func (m *TokenMutator) Pos() token.Pos {
+	if m.spec != nil {
+		return m.spec.Pos(m.node)
+	}
+
 	return m.tokenNode.TokPos
 }
 
@@ -95,15 +210,18 @@ func (m *TokenMutator) Pkg() string {
 	return m.pkg
 }
 
-// Apply saves the original token.Token of the mutator.Mutator and sets the
-// current token from the tokenMutations table.
+// Apply mutates the ast.Node of the mutator.Mutator, either using its
+// MutatorSpec or setting the current token from the tokenMutations table.
 // Apply overwrites the source code file with the mutated one. It also
 // stores the original file in the TokenMutator in order to allow
 // Rollback to put it back later.
 //
-// Apply also puts back the original Token after the mutated file write.
+// Apply also restores the original ast.Node after the mutated file write.
 // This is done in order to facilitate the atomicity of the operation,
 // avoiding locking in a method and unlocking in another.
+//
+// With the self-check, Apply returns ErrSourceUnchanged without writing the
+// file if the mutation doesn't change the source.
 func (m *TokenMutator) Apply() error {
 	fileLock(m.Position().Filename).Lock()
 	defer fileLock(m.Position().Filename).Unlock()
@@ -115,34 +233,70 @@ func (m *TokenMutator) Apply() error {
 		return err
 	}
 
-	m.actualToken = m.tokenNode.Tok()
-	m.tokenNode.SetTok(tokenMutations[m.Type()][m.tokenNode.Tok()])
-
-	if err = m.writeMutatedFile(filename); err != nil {
-		return err
+	var unmutated []byte
+	if m.selfCheck {
+		unmutated, err = m.print()
+		if err != nil {
+			return err
+		}
 	}
 
+	restore := m.mutate()
 	// Rollback here to facilitate the atomicity of the operation.
-	m.tokenNode.SetTok(m.actualToken)
+	defer restore()
 
-	return nil
+	return m.writeMutatedFile(filename, unmutated)
 }
 
-func (m *TokenMutator) writeMutatedFile(filename string) error {
-	w := &bytes.Buffer{}
-	err := printer.Fprint(w, m.fs, m.file)
+func (m *TokenMutator) mutate() func() {
+	if m.spec != nil {
+		return m.spec.Mutate(m.node)
+	}
+	actualToken := m.tokenNode.Tok()
+	m.tokenNode.SetTok(tokenMutations[m.Type()][actualToken])
+
+	return func() {
+		m.tokenNode.SetTok(actualToken)
+	}
+}
+
+// writeMutatedFile writes the mutated file. If the unmutated printed source
+// is given, the mutated one is checked against it, and the file is not
+// written when they are the same.
+func (m *TokenMutator) writeMutatedFile(filename string, unmutated []byte) error {
+	mutated, err := m.print()
 	if err != nil {
 		return err
 	}
+	if unmutated != nil && bytes.Equal(unmutated, mutated) {
+		log.Errorf("self-check: the %s mutant at %s doesn't change the source, this is a bug of Gremlins\n", m.Type(), m.Position())
+		m.unchanged = true
+		m.resetOrigFile()
 
-	err = os.WriteFile(filename, w.Bytes(), 0600)
+		return ErrSourceUnchanged
+	}
+
+	err = m.writeFile(filename, mutated)
 	if err != nil {
 		return err
 	}
+	if m.livedDiff {
+		m.mutated = mutated
+	}
 
 	return nil
 }
 
+// print prints the source of the current AST of the file.
+func (m *TokenMutator) print() ([]byte, error) {
+	w := &bytes.Buffer{}
+	if err := printer.Fprint(w, m.fs, m.file); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
 var locks = make(map[string]*sync.Mutex)
 var mutex sync.RWMutex
 
@@ -174,12 +328,78 @@ func cachedLock(filename string) (*sync.Mutex, bool) {
 }
 
 // Rollback puts back the original file after the test and cleans up the
-// TokenMutator to free memory.
+// TokenMutator to free memory. The diff of a LIVED TokenMutator is computed
+// before, if the lived diffs are enabled.
 func (m *TokenMutator) Rollback() error {
 	defer m.resetOrigFile()
+	if m.mutated != nil && m.status == mutator.Lived {
+		m.diff = unifiedDiff(m.Position().Filename, m.origFile, m.mutated)
+	}
 	filename := filepath.Join(m.workDir, m.Position().Filename)
 
-	return os.WriteFile(filename, m.origFile, 0600)
+	return m.writeFile(filename, m.origFile)
+}
+
+// writeFile writes the file, retrying it up to writeRetries times on
+// failure. The delay between the attempts doubles each time.
+func (m *TokenMutator) writeFile(filename string, data []byte) error {
+	m.writes.acquire()
+	defer m.writes.release()
+
+	err := m.writeWithMode(filename, data)
+	backoff := writeRetryBackoff
+	for i := 0; err != nil && i < m.writeRetries; i++ {
+		time.Sleep(backoff)
+		backoff *= 2
+		err = m.writeWithMode(filename, data)
+	}
+
+	return err
+}
+
+// writeWithMode writes the file and, if the fileMode is set, changes its
+// permissions, since the write only applies them to a new file.
+func (m *TokenMutator) writeWithMode(filename string, data []byte) error {
+	if m.fileMode == 0 {
+		return writeFile(filename, data, 0600)
+	}
+	if err := writeFile(filename, data, m.fileMode); err != nil {
+		return err
+	}
+
+	return os.Chmod(filename, m.fileMode)
+}
+
+// writeFile writes the files of the mutants, it is a variable to allow
+// observing the writes in tests.
+var writeFile = os.WriteFile
+
+// writeRetryBackoff is the delay before the first retry of a failed write.
+var writeRetryBackoff = 10 * time.Millisecond
+
+// writeLimiter limits the number of concurrent file writes across all the
+// mutants, to avoid thrashing slow disks. A nil writeLimiter doesn't limit
+// the writes.
+type writeLimiter chan struct{}
+
+func newWriteLimiter(n int) writeLimiter {
+	if n <= 0 {
+		return nil
+	}
+
+	return make(writeLimiter, n)
+}
+
+func (l writeLimiter) acquire() {
+	if l != nil {
+		l <- struct{}{}
+	}
+}
+
+func (l writeLimiter) release() {
+	if l != nil {
+		<-l
+	}
 }
 
 // SetWorkdir sets the base path on which to Apply and Rollback operations.
@@ -199,4 +419,5 @@ func (m *TokenMutator) Workdir() string {
 func (m *TokenMutator) resetOrigFile() {
 	var zeroByte []byte
 	m.origFile = zeroByte
+	m.mutated = nil
 }
@@ -0,0 +1,279 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+func TestWriteLimiter(t *testing.T) {
+	const limit = 2
+	var current, peak atomic.Int64
+	writeFile = func(name string, data []byte, perm os.FileMode) error {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		return os.WriteFile(name, data, perm)
+	}
+	defer func() { writeFile = os.WriteFile }()
+
+	workdir := t.TempDir()
+	writes := newWriteLimiter(limit)
+	var mutants []*TokenMutator
+	for i := 0; i < 10; i++ {
+		mutants = append(mutants, newLimitedMutant(t, workdir, fmt.Sprintf("file%d.go", i), writes))
+	}
+
+	wg := sync.WaitGroup{}
+	for _, m := range mutants {
+		m := m
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := m.Apply(); err != nil {
+				t.Error(err)
+			}
+			if err := m.Rollback(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("expected at most %d concurrent writes, got %d", limit, got)
+	}
+}
+
+func TestWriteLimiterDisabled(t *testing.T) {
+	if l := newWriteLimiter(0); l != nil {
+		t.Errorf("expected no limiter, got one of %d", cap(l))
+	}
+}
+
+func TestWriteRetries(t *testing.T) {
+	writeRetryBackoff = time.Millisecond
+	defer func() { writeRetryBackoff = 10 * time.Millisecond }()
+
+	testCases := []struct {
+		name     string
+		failures int
+		retries  int
+		wantErr  bool
+	}{
+		{name: "it succeeds after transient failures", failures: 2, retries: 3},
+		{name: "it fails when the retries are exhausted", failures: 3, retries: 2, wantErr: true},
+		{name: "it doesn't retry by default", failures: 1, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			writeFile = func(name string, data []byte, perm os.FileMode) error {
+				calls++
+				if calls <= tc.failures {
+					return fmt.Errorf("transient failure %d", calls)
+				}
+
+				return os.WriteFile(name, data, perm)
+			}
+			defer func() { writeFile = os.WriteFile }()
+
+			m := newLimitedMutant(t, t.TempDir(), "file.go", nil)
+			m.writeRetries = tc.retries
+
+			err := m.Apply()
+			if tc.wantErr {
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected Apply to succeed, got %s", err)
+			}
+			if calls != tc.failures+1 {
+				t.Errorf("expected %d writes, got %d", tc.failures+1, calls)
+			}
+		})
+	}
+}
+
+func TestWriteFileMode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fileMode os.FileMode
+		want     os.FileMode
+	}{
+		{name: "it keeps the mode of the existing files by default", want: 0644},
+		{name: "it writes the files with the configured mode", fileMode: 0664, want: 0664},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			workdir := t.TempDir()
+			m := newLimitedMutant(t, workdir, "file.go", nil)
+			m.fileMode = tc.fileMode
+			filename := filepath.Join(workdir, "file.go")
+			if err := os.Chmod(filename, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := m.Apply(); err != nil {
+				t.Fatal(err)
+			}
+			assertPerm(t, filename, tc.want)
+			if err := m.Rollback(); err != nil {
+				t.Fatal(err)
+			}
+			assertPerm(t, filename, tc.want)
+		})
+	}
+}
+
+func assertPerm(t *testing.T, filename string, want os.FileMode) {
+	t.Helper()
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != want {
+		t.Errorf("expected the file to have mode %s, got %s", want, got)
+	}
+}
+
+func TestSelfCheck(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\t_ = 1 + 2\n}\n"
+	identity := MutatorSpec{
+		Type: mutator.ArithmeticBase,
+		Matches: func(n ast.Node) bool {
+			_, ok := n.(*ast.BinaryExpr)
+
+			return ok
+		},
+		Pos: func(n ast.Node) token.Pos {
+			return n.Pos()
+		},
+		Mutate: func(ast.Node) func() {
+			return func() {}
+		},
+	}
+	testCases := []struct {
+		name      string
+		spec      MutatorSpec
+		selfCheck bool
+		wantBug   bool
+	}{
+		{name: "it flags an identity mutation", spec: identity, selfCheck: true, wantBug: true},
+		{name: "it doesn't flag a mutation changing the source", spec: tokenSpec(mutator.ArithmeticBase), selfCheck: true},
+		{name: "it doesn't check without self-check", spec: identity},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, errOut)
+			defer log.Reset()
+			workdir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(workdir, "main.go"), []byte(src), 0600); err != nil {
+				t.Fatal(err)
+			}
+			set := token.NewFileSet()
+			f, _ := parser.ParseFile(set, "main.go", src, parser.ParseComments)
+			var node ast.Node
+			ast.Inspect(f, func(n ast.Node) bool {
+				if _, ok := n.(*ast.BinaryExpr); ok {
+					node = n
+				}
+
+				return true
+			})
+			m := NewSpecMutant("example.com", set, f, node, tc.spec)
+			m.SetWorkdir(workdir)
+			m.selfCheck = tc.selfCheck
+
+			err := m.Apply()
+			if tc.wantBug {
+				if !errors.Is(err, ErrSourceUnchanged) {
+					t.Fatalf("expected %v, got %v", ErrSourceUnchanged, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := m.Rollback(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if m.Unchanged() != tc.wantBug {
+				t.Errorf("expected the mutant to be unchanged %v, got %v", tc.wantBug, m.Unchanged())
+			}
+			got := strings.Contains(errOut.String(), "self-check: the ARITHMETIC_BASE mutant at main.go:4:6 doesn't change the source")
+			if got != tc.wantBug {
+				t.Errorf("expected the mutant to be logged %v, got the log:\n%s", tc.wantBug, errOut.String())
+			}
+		})
+	}
+}
+
+func newLimitedMutant(t *testing.T, workdir, filename string, writes writeLimiter) *TokenMutator {
+	t.Helper()
+	src := "package main\n\nfunc main() {\n\t_ = 1 + 2\n}\n"
+	if err := os.WriteFile(filepath.Join(workdir, filename), []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+	set := token.NewFileSet()
+	f, err := parser.ParseFile(set, filename, src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var node *NodeToken
+	ast.Inspect(f, func(n ast.Node) bool {
+		if tn, ok := NewTokenNode(n); ok && node == nil {
+			node = tn
+		}
+
+		return true
+	})
+	m := NewTokenMutant("example.com", set, f, node)
+	m.SetType(mutator.ArithmeticBase)
+	m.SetWorkdir(workdir)
+	m.writes = writes
+
+	return m
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/go-gremlins/gremlins/internal/configuration"
 	"github.com/go-gremlins/gremlins/internal/engine"
 	"github.com/go-gremlins/gremlins/internal/mutator"
 )
@@ -97,3 +98,39 @@ func TestMutantApplyAndRollback(t *testing.T) {
 		}
 	}
 }
+
+func TestMutantDiff(t *testing.T) {
+	src := "package main\n\nfunc main() {\n\ta := 1\n\tif a > 2 {\n\t\ta++\n\t}\n}\n"
+	diff := "--- a/main.go\n+++ b/main.go\n@@ -2,7 +2,7 @@\n \n func main() {\n \ta := 1\n" +
+		"-\tif a > 2 {\n+\tif a >= 2 {\n \t\ta++\n \t}\n }\n"
+	testCases := []struct {
+		name      string
+		status    mutator.Status
+		livedDiff bool
+		want      string
+	}{
+		{name: "it keeps the diff of a LIVED mutant", status: mutator.Lived, livedDiff: true, want: diff},
+		{name: "it doesn't keep the diff of a KILLED mutant", status: mutator.Killed, livedDiff: true},
+		{name: "it doesn't keep the diff without lived diff", status: mutator.Lived},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found := discoverMutantsWithConfig(t, src, mutator.ConditionalsBoundary, map[string]any{
+				configuration.UnleashLivedDiffKey: tc.livedDiff,
+			})
+			if len(found) != 1 {
+				t.Fatalf("expected 1 mutant, got %d", len(found))
+			}
+			got := found[0]
+			// The status is set by the executor after the tests, before the
+			// rollback.
+			got.SetStatus(tc.status)
+
+			_ = applyMutant(t, got, src)
+
+			if !cmp.Equal(got.Diff(), tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got.Diff()))
+			}
+		})
+	}
+}
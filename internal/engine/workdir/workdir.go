@@ -21,11 +21,24 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sync"
 
 	"github.com/go-gremlins/gremlins/internal/log"
 )
 
+// copyPrefix is the prefix of the names of the copies of the source
+// directory, which os.MkdirTemp follows with a random number.
+const copyPrefix = "wd-"
+
+var copyPattern = regexp.MustCompile(`^` + copyPrefix + `\d+$`)
+
+// IsCopy tells whether the directory name is the one of a copy of the source
+// directory made by a Dealer, ex. left behind by a previous run.
+func IsCopy(name string) bool {
+	return copyPattern.MatchString(name)
+}
+
 // Dealer is the responsible for creating and returning the reference
 // to a workdir to use during mutation testing instead of the actual
 // source code.
@@ -45,22 +58,37 @@ type Dealer interface {
 // Gremlins not to work in the actual source directory messing up
 // with the source code files.
 type CachedDealer struct {
-	mutex   *sync.RWMutex
-	cache   map[string]string
-	workDir string
-	srcDir  string
+	mutex    *sync.RWMutex
+	cache    map[string]string
+	workDir  string
+	srcDir   string
+	fileMode fs.FileMode
+}
+
+// Option for the CachedDealer initialisation.
+type Option func(cd *CachedDealer)
+
+// WithFileMode sets the permissions of the copied files, instead of the ones
+// of the source files. They are set regardless of the umask.
+func WithFileMode(mode fs.FileMode) Option {
+	return func(cd *CachedDealer) {
+		cd.fileMode = mode
+	}
 }
 
 // NewCachedDealer instantiates a new Dealer that keeps a cache of the
 // instantiated folders. Every time a new working directory is requested
 // with the same identifier, the same folder reference is returned.
-func NewCachedDealer(workDir, srcDir string) *CachedDealer {
+func NewCachedDealer(workDir, srcDir string, opts ...Option) *CachedDealer {
 	dealer := &CachedDealer{
 		mutex:   &sync.RWMutex{},
 		cache:   make(map[string]string),
 		workDir: workDir,
 		srcDir:  srcDir,
 	}
+	for _, opt := range opts {
+		opt(dealer)
+	}
 
 	return dealer
 }
@@ -73,7 +101,7 @@ func (cd *CachedDealer) Get(idf string) (string, error) {
 		return dstDir, nil
 	}
 
-	dstDir, err := os.MkdirTemp(cd.workDir, "wd-*")
+	dstDir, err := os.MkdirTemp(cd.workDir, copyPrefix+"*")
 	if err != nil {
 		return "", err
 	}
@@ -132,20 +160,25 @@ func (cd *CachedDealer) copyTo(dstDir string) func(srcPath string, info fs.FileI
 		if relPath == "." {
 			return nil
 		}
+		if info.IsDir() && (srcPath == cd.workDir || IsCopy(info.Name())) {
+			// The workdir inside the source directory would be copied
+			// into itself.
+			return filepath.SkipDir
+		}
 		dstPath := filepath.Join(dstDir, relPath)
 
-		return copyPath(srcPath, dstPath, info)
+		return copyPath(srcPath, dstPath, info, cd.fileMode)
 	}
 }
 
-func copyPath(srcPath, dstPath string, info fs.FileInfo) error {
+func copyPath(srcPath, dstPath string, info fs.FileInfo, fileMode fs.FileMode) error {
 	switch mode := info.Mode(); {
 	case mode.IsDir():
 		if err := os.Mkdir(dstPath, mode); err != nil && !os.IsExist(err) {
 			return err
 		}
 	case mode.IsRegular():
-		if err := doCopy(srcPath, dstPath, mode); err != nil {
+		if err := doCopy(srcPath, dstPath, mode, fileMode); err != nil {
 			return err
 		}
 	}
@@ -153,16 +186,27 @@ func copyPath(srcPath, dstPath string, info fs.FileInfo) error {
 	return nil
 }
 
-func doCopy(srcPath, dstPath string, fileMode fs.FileMode) error {
+// doCopy copies the file with the permissions of the source file, or with
+// forcedMode if it is set.
+func doCopy(srcPath, dstPath string, fileMode, forcedMode fs.FileMode) error {
 	s, err := os.Open(srcPath)
 	if err != nil {
 		return err
 	}
+	if forcedMode != 0 {
+		fileMode = forcedMode
+	}
 	//nolint:nosnakecase
 	d, err := os.OpenFile(dstPath, os.O_CREATE|os.O_RDWR, fileMode)
 	if err != nil {
 		return err
 	}
+	if forcedMode != 0 {
+		// The umask applies to the created file.
+		if err = d.Chmod(forcedMode); err != nil {
+			return err
+		}
+	}
 
 	if _, err = io.Copy(d, s); err != nil {
 		return err
@@ -87,6 +87,63 @@ func checkForDifferentFile(t *testing.T, srcDir string, dstDir string) func(path
 	}
 }
 
+func TestCopyFolderWithFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the file modes are not supported on Windows")
+	}
+	srcDir := t.TempDir()
+	populateSrcDir(t, srcDir, 1)
+	wdDir := t.TempDir()
+
+	const mode fs.FileMode = 0o664
+	dealer := workdir.NewCachedDealer(wdDir, srcDir, workdir.WithFileMode(mode))
+	defer dealer.Clean()
+
+	dstDir, err := dealer.Get("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = filepath.Walk(dstDir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() && info.Mode().Perm() != mode {
+			t.Errorf("expected %s to have mode %s, got %s", path, mode, info.Mode().Perm())
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSkipsWorkDirs(t *testing.T) {
+	srcDir := t.TempDir()
+	populateSrcDir(t, srcDir, 0)
+	for _, dir := range []string{"wd-1234", "gremlins-1"} {
+		if err := os.Mkdir(filepath.Join(srcDir, dir), 0o700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wdDir := filepath.Join(srcDir, "gremlins-1")
+
+	dealer := workdir.NewCachedDealer(wdDir, srcDir)
+	defer dealer.Clean()
+
+	dstDir, err := dealer.Get("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range []string{"wd-1234", "gremlins-1"} {
+		if _, err := os.Stat(filepath.Join(dstDir, dir)); !os.IsNotExist(err) {
+			t.Errorf("expected %s not to be copied", dir)
+		}
+	}
+}
+
 func TestCachesFolder(t *testing.T) {
 	t.Run("caches copy folders", func(t *testing.T) {
 		srcDir := t.TempDir()
@@ -20,6 +20,7 @@ import (
 	"go/token"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/go-gremlins/gremlins/internal/configuration"
 	"github.com/go-gremlins/gremlins/internal/engine/workerpool"
@@ -203,3 +204,51 @@ func (fakeMutant) Apply() error {
 func (fakeMutant) Rollback() error {
 	panic("not used in test")
 }
+
+func (fakeMutant) Duration() time.Duration {
+	panic("not used in test")
+}
+
+func (fakeMutant) SetDuration(_ time.Duration) {
+	panic("not used in test")
+}
+
+func (fakeMutant) NodeKind() mutator.NodeKind {
+	panic("not used in test")
+}
+
+func (fakeMutant) SetNodeKind(_ mutator.NodeKind) {
+	panic("not used in test")
+}
+
+func (fakeMutant) InInit() bool {
+	panic("not used in test")
+}
+
+func (fakeMutant) SetInInit(_ bool) {
+	panic("not used in test")
+}
+
+func (fakeMutant) SuspectKill() bool {
+	panic("not used in test")
+}
+
+func (fakeMutant) SetSuspectKill(_ bool) {
+	panic("not used in test")
+}
+
+func (fakeMutant) Unchanged() bool {
+	panic("not used in test")
+}
+
+func (fakeMutant) Function() string {
+	panic("not used in test")
+}
+
+func (fakeMutant) SetFunction(_ string) {
+	panic("not used in test")
+}
+
+func (fakeMutant) Diff() string {
+	panic("not used in test")
+}
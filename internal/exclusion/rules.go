@@ -2,6 +2,7 @@ package exclusion
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 
 	"github.com/spf13/viper"
@@ -43,3 +44,15 @@ func (r Rules) IsFileExcluded(path string) bool {
 
 	return false
 }
+
+// GeneratedDirs returns the directories configured as generated, relative to
+// the module root. They are cleaned and use forward slashes, like the paths
+// walked by the engine.
+func GeneratedDirs() []string {
+	var dirs []string
+	for _, d := range viper.GetStringSlice(configuration.UnleashGeneratedDirsKey) {
+		dirs = append(dirs, filepath.ToSlash(filepath.Clean(d)))
+	}
+
+	return dirs
+}
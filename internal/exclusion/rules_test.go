@@ -48,6 +48,17 @@ func TestRules_IsFileExcluded(t *testing.T) {
 
 }
 
+func TestGeneratedDirs(t *testing.T) {
+	configuration.Set(configuration.UnleashGeneratedDirsKey, []any{"./gen/", "internal/pb"})
+	defer configuration.Reset()
+
+	got := GeneratedDirs()
+
+	if len(got) != 2 || got[0] != "gen" || got[1] != "internal/pb" {
+		t.Errorf("expected the cleaned dirs, got %v", got)
+	}
+}
+
 func countTrue(ss []string, f func(s string) bool) int {
 	count := 0
 
@@ -26,6 +26,16 @@ func (e ErrorType) String() string {
 		return "below efficacy-threshold"
 	case MutantCoverageThreshold:
 		return "below mutant coverage-threshold"
+	case NotViableThreshold:
+		return "above not-viable-threshold"
+	case NoCoverage:
+		return "no test coverage detected"
+	case ExcludedMutants:
+		return "mutants found in excluded files"
+	case HighSeverityLived:
+		return "LIVED mutants of high severity"
+	case FailFast:
+		return "LIVED mutant found in fail-fast mode"
 	}
 	panic("this should not happen")
 }
@@ -37,11 +47,34 @@ const (
 	// MutantCoverageThreshold is the error type raised when mutant coverage is
 	// below threshold.
 	MutantCoverageThreshold
+
+	// NotViableThreshold is the error type raised in strict mode when the
+	// NOT VIABLE ratio is above threshold.
+	NotViableThreshold
+
+	// NoCoverage is the error type raised when the module has no test
+	// coverage at all and the run is configured to fail on it.
+	NoCoverage
+
+	// ExcludedMutants is the error type raised when the excluded files
+	// contain mutants and the run is configured to fail on it.
+	ExcludedMutants
+	// HighSeverityLived is the error type raised when mutants of a type
+	// configured with high severity are LIVED.
+	HighSeverityLived
+	// FailFast is the error type raised in fail-fast mode when the run is
+	// stopped at the first LIVED mutant.
+	FailFast
 )
 
 var errorMapping = map[ErrorType]int{
 	EfficacyThreshold:       10,
 	MutantCoverageThreshold: 11,
+	NotViableThreshold:      12,
+	NoCoverage:              13,
+	ExcludedMutants:         14,
+	HighSeverityLived:       15,
+	FailFast:                16,
 }
 
 // ExitError is a special Error that is raised when special conditions require
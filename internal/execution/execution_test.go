@@ -41,6 +41,36 @@ func TestExitErr(t *testing.T) {
 			wantExitMsg:  "below mutant coverage-threshold",
 			wantExitCode: 11,
 		},
+		{
+			name:         "not-viable-threshold",
+			errorType:    execution.NotViableThreshold,
+			wantExitMsg:  "above not-viable-threshold",
+			wantExitCode: 12,
+		},
+		{
+			name:         "no-coverage",
+			errorType:    execution.NoCoverage,
+			wantExitMsg:  "no test coverage detected",
+			wantExitCode: 13,
+		},
+		{
+			name:         "excluded-mutants",
+			errorType:    execution.ExcludedMutants,
+			wantExitMsg:  "mutants found in excluded files",
+			wantExitCode: 14,
+		},
+		{
+			name:         "high-severity-lived",
+			errorType:    execution.HighSeverityLived,
+			wantExitMsg:  "LIVED mutants of high severity",
+			wantExitCode: 15,
+		},
+		{
+			name:         "fail-fast",
+			errorType:    execution.FailFast,
+			wantExitMsg:  "LIVED mutant found in fail-fast mode",
+			wantExitCode: 16,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -27,6 +27,7 @@ import (
 )
 
 var fgRed = color.New(color.FgRed).SprintFunc()
+var fgYellow = color.New(color.FgYellow).SprintFunc()
 
 var mutex = &sync.Mutex{}
 var instance *log
@@ -71,6 +72,15 @@ func Infoln(a any) {
 	instance.writeln(a)
 }
 
+// Warnf logs a warning using format.
+func Warnf(f string, args ...any) {
+	if instance == nil {
+		return
+	}
+	msg := fmt.Sprintf(f, args...)
+	instance.eWritef("%s: %s", fgYellow("WARNING"), msg)
+}
+
 // Errorf logs an error using format.
 func Errorf(f string, args ...any) {
 	if instance == nil {
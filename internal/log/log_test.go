@@ -72,6 +72,27 @@ func TestLogInfo(t *testing.T) {
 	log.Reset()
 }
 
+func TestLogWarning(t *testing.T) {
+	out := &bytes.Buffer{}
+	eOut := &bytes.Buffer{}
+	log.Init(out, eOut)
+	defer log.Reset()
+
+	log.Warnf("test %d", 1)
+
+	got := eOut.String()
+
+	want := "WARNING: test 1"
+	if got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+
+	got = out.String()
+	if got != "" {
+		t.Errorf("expected out to be empty, got %s", got)
+	}
+}
+
 func TestLogError(t *testing.T) {
 	out := &bytes.Buffer{}
 	eOut := &bytes.Buffer{}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package mutator
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFingerprint is returned when parsing a fingerprint which is not
+// in the 'file:line:column:TYPE' format.
+var ErrInvalidFingerprint = errors.New("invalid fingerprint, the format is 'file:line:column:TYPE'")
+
+// Fingerprints is a set of mutation fingerprints. It is used to select the
+// same mutants across different runs.
+type Fingerprints map[string]struct{}
+
+// NewFingerprint builds the fingerprint of a mutation from its position and
+// the name of its Type.
+func NewFingerprint(pos token.Position, typeName string) string {
+	return fmt.Sprintf("%s:%d:%d:%s", pos.Filename, pos.Line, pos.Column, typeName)
+}
+
+// ParseFingerprint parses a fingerprint built by NewFingerprint, returning
+// the position and the name of the Type of the mutation.
+func ParseFingerprint(fp string) (token.Position, string, error) {
+	parts := strings.Split(fp, ":")
+	n := len(parts)
+	if n < 4 {
+		return token.Position{}, "", ErrInvalidFingerprint
+	}
+	line, err := strconv.Atoi(parts[n-3])
+	if err != nil {
+		return token.Position{}, "", ErrInvalidFingerprint
+	}
+	column, err := strconv.Atoi(parts[n-2])
+	if err != nil {
+		return token.Position{}, "", ErrInvalidFingerprint
+	}
+	// The file name can contain colons, like a Windows volume name.
+	pos := token.Position{Filename: strings.Join(parts[:n-3], ":"), Line: line, Column: column}
+
+	return pos, parts[n-1], nil
+}
+
+// Add adds the fingerprint of a mutation to the Fingerprints.
+func (f Fingerprints) Add(pos token.Position, typeName string) {
+	f[NewFingerprint(pos, typeName)] = struct{}{}
+}
+
+// Contains checks if the fingerprint of the Mutator is in the Fingerprints.
+func (f Fingerprints) Contains(m Mutator) bool {
+	_, ok := f[NewFingerprint(m.Position(), m.Type().String())]
+
+	return ok
+}
@@ -16,7 +16,11 @@
 
 package mutator
 
-import "go/token"
+import (
+	"go/token"
+	"sync"
+	"time"
+)
 
 // Status represents the status of a given TokenMutant.
 //
@@ -28,6 +32,8 @@ import "go/token"
 //     means the test suite is not effective in catching it.
 //   - Killed means that the TokenMutant has been tested and the tests failed, which
 //     means they are effective in covering this regression.
+//   - NoTests means that a TokenMutant has been identified, but its package has no
+//     test files, so running its tests would pass without testing anything.
 type Status int
 
 // Currently supported MutantStatus.
@@ -39,6 +45,7 @@ const (
 	Killed
 	NotViable
 	TimedOut
+	NoTests
 )
 
 func (ms Status) String() string {
@@ -57,6 +64,8 @@ func (ms Status) String() string {
 		return "NOT VIABLE"
 	case TimedOut:
 		return "TIMED OUT"
+	case NoTests:
+		return "NO TESTS"
 	default:
 		panic("this should not happen")
 	}
@@ -83,6 +92,28 @@ const (
 	InvertLoopCtrl
 	InvertNegatives
 	RemoveSelfAssignments
+	DropAppendArg
+	InvertErrorCheck
+	NilCheckInvert
+	MinMaxSwap
+	DropStructField
+	ContinueToReturn
+	RangeCountBoundary
+	DropLogicalOperand
+	SliceBoundary
+	SwitchCaseValue
+	InjectEarlyReturn
+	FloatSignFlip
+	ContextReplace
+	RemoveGoStmt
+	RemoveElse
+	CollectionElement
+	StrictnessToggle
+	RemoveDefaultCase
+
+	// firstCustomType must remain the last one, since the custom Type
+	// registered with NewType are numbered starting from it.
+	firstCustomType
 )
 
 // Types allows to iterate over Type.
@@ -98,6 +129,24 @@ var Types = []Type{
 	InvertLoopCtrl,
 	InvertNegatives,
 	RemoveSelfAssignments,
+	DropAppendArg,
+	InvertErrorCheck,
+	NilCheckInvert,
+	MinMaxSwap,
+	DropStructField,
+	ContinueToReturn,
+	RangeCountBoundary,
+	DropLogicalOperand,
+	SliceBoundary,
+	SwitchCaseValue,
+	InjectEarlyReturn,
+	FloatSignFlip,
+	ContextReplace,
+	RemoveGoStmt,
+	RemoveElse,
+	CollectionElement,
+	StrictnessToggle,
+	RemoveDefaultCase,
 }
 
 func (mt Type) String() string {
@@ -124,12 +173,88 @@ func (mt Type) String() string {
 		return "INVERT_BWASSIGN"
 	case RemoveSelfAssignments:
 		return "REMOVE_SELF_ASSIGNMENTS"
+	case DropAppendArg:
+		return "DROP_APPEND_ARG"
+	case InvertErrorCheck:
+		return "INVERT_ERROR_CHECK"
+	case NilCheckInvert:
+		return "NIL_CHECK_INVERT"
+	case MinMaxSwap:
+		return "MIN_MAX_SWAP"
+	case DropStructField:
+		return "DROP_STRUCT_FIELD"
+	case ContinueToReturn:
+		return "CONTINUE_TO_RETURN"
+	case RangeCountBoundary:
+		return "RANGE_COUNT_BOUNDARY"
+	case DropLogicalOperand:
+		return "DROP_LOGICAL_OPERAND"
+	case SliceBoundary:
+		return "SLICE_BOUNDARY"
+	case SwitchCaseValue:
+		return "SWITCH_CASE_VALUE"
+	case InjectEarlyReturn:
+		return "INJECT_EARLY_RETURN"
+	case FloatSignFlip:
+		return "FLOAT_SIGN_FLIP"
+	case ContextReplace:
+		return "CONTEXT_REPLACE"
+	case RemoveGoStmt:
+		return "REMOVE_GO_STMT"
+	case RemoveElse:
+		return "REMOVE_ELSE"
+	case CollectionElement:
+		return "COLLECTION_ELEMENT"
+	case StrictnessToggle:
+		return "STRICTNESS_TOGGLE"
+	case RemoveDefaultCase:
+		return "REMOVE_DEFAULT_CASE"
 
 	default:
+		return customTypeName(mt)
+	}
+}
+
+var customTypes []string
+var customTypesMutex sync.RWMutex
+
+// NewType registers a custom Type with the given name and adds it to Types.
+// It is used to register the Type of custom mutators, and it must be called
+// before the Gremlins command is initialised.
+func NewType(name string) Type {
+	customTypesMutex.Lock()
+	defer customTypesMutex.Unlock()
+	customTypes = append(customTypes, name)
+	mt := firstCustomType + Type(len(customTypes)-1)
+	Types = append(Types, mt)
+
+	return mt
+}
+
+func customTypeName(mt Type) string {
+	customTypesMutex.RLock()
+	defer customTypesMutex.RUnlock()
+	i := int(mt - firstCustomType)
+	if i < 0 || i >= len(customTypes) {
 		panic("this should not happen")
 	}
+
+	return customTypes[i]
 }
 
+// NodeKind represents the kind of source code position mutated by a Mutator.
+type NodeKind int
+
+// The currently recognised NodeKind.
+//
+//   - GenericNode is any position without a specific meaning for Gremlins.
+//   - LoopControlNode is a position that controls a loop, like the condition
+//     or the post statement of a for loop, or a break/continue statement.
+const (
+	GenericNode NodeKind = iota
+	LoopControlNode
+)
+
 // Mutator represents a possible mutation of the source code.
 type Mutator interface {
 	// Type returns the Type of the Mutator.
@@ -168,4 +293,47 @@ type Mutator interface {
 	// Rollback removes the mutation from the source code and sets it back to
 	// its original status.
 	Rollback() error
+
+	// Duration returns the time it took to run the tests on the Mutator.
+	// It is zero if the tests have not been run.
+	Duration() time.Duration
+
+	// SetDuration sets the time it took to run the tests on the Mutator.
+	SetDuration(d time.Duration)
+
+	// NodeKind returns the NodeKind of the position mutated by the Mutator.
+	NodeKind() NodeKind
+
+	// SetNodeKind sets the NodeKind of the position mutated by the Mutator.
+	SetNodeKind(k NodeKind)
+
+	// InInit tells whether the Mutator is in an init function, whose
+	// mutations can make the package fail to load.
+	InInit() bool
+
+	// SetInInit sets whether the Mutator is in an init function.
+	SetInInit(in bool)
+
+	// Function returns the name of the function enclosing the Mutator, ex.
+	// parseConfig, (*Parser).Parse or parseConfig.func1 for a closure. It is
+	// empty outside the functions.
+	Function() string
+
+	// SetFunction sets the name of the function enclosing the Mutator.
+	SetFunction(name string)
+
+	// SuspectKill tells whether the Mutator is KILLED, but the tests fail
+	// without the mutation as well, so the kill can't be trusted.
+	SuspectKill() bool
+
+	// SetSuspectKill sets whether the kill of the Mutator is suspect.
+	SetSuspectKill(suspect bool)
+
+	// Unchanged tells whether the self-check found that the Mutator doesn't
+	// change the source, which is a bug of the mutator.
+	Unchanged() bool
+
+	// Diff returns the unified diff of the change made by Apply on the
+	// source code. It is empty if the Mutator has not been applied.
+	Diff() string
 }
@@ -17,6 +17,8 @@
 package mutator_test
 
 import (
+	"errors"
+	"go/token"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -60,6 +62,11 @@ func TestStatusString(t *testing.T) {
 			expected:       "TIMED OUT",
 			mutationStatus: mutator.TimedOut,
 		},
+		{
+			name:           "NoTests",
+			expected:       "NO TESTS",
+			mutationStatus: mutator.NoTests,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -132,6 +139,96 @@ func TestTypeString(t *testing.T) {
 			expected:   "REMOVE_SELF_ASSIGNMENTS",
 			mutantType: mutator.RemoveSelfAssignments,
 		},
+		{
+			name:       "DROP_APPEND_ARG",
+			expected:   "DROP_APPEND_ARG",
+			mutantType: mutator.DropAppendArg,
+		},
+		{
+			name:       "INVERT_ERROR_CHECK",
+			expected:   "INVERT_ERROR_CHECK",
+			mutantType: mutator.InvertErrorCheck,
+		},
+		{
+			name:       "NIL_CHECK_INVERT",
+			expected:   "NIL_CHECK_INVERT",
+			mutantType: mutator.NilCheckInvert,
+		},
+		{
+			name:       "MIN_MAX_SWAP",
+			expected:   "MIN_MAX_SWAP",
+			mutantType: mutator.MinMaxSwap,
+		},
+		{
+			name:       "DROP_STRUCT_FIELD",
+			expected:   "DROP_STRUCT_FIELD",
+			mutantType: mutator.DropStructField,
+		},
+		{
+			name:       "CONTINUE_TO_RETURN",
+			expected:   "CONTINUE_TO_RETURN",
+			mutantType: mutator.ContinueToReturn,
+		},
+		{
+			name:       "RANGE_COUNT_BOUNDARY",
+			expected:   "RANGE_COUNT_BOUNDARY",
+			mutantType: mutator.RangeCountBoundary,
+		},
+		{
+			name:       "DROP_LOGICAL_OPERAND",
+			expected:   "DROP_LOGICAL_OPERAND",
+			mutantType: mutator.DropLogicalOperand,
+		},
+		{
+			name:       "SLICE_BOUNDARY",
+			expected:   "SLICE_BOUNDARY",
+			mutantType: mutator.SliceBoundary,
+		},
+		{
+			name:       "SWITCH_CASE_VALUE",
+			expected:   "SWITCH_CASE_VALUE",
+			mutantType: mutator.SwitchCaseValue,
+		},
+		{
+			name:       "INJECT_EARLY_RETURN",
+			expected:   "INJECT_EARLY_RETURN",
+			mutantType: mutator.InjectEarlyReturn,
+		},
+		{
+			name:       "FLOAT_SIGN_FLIP",
+			expected:   "FLOAT_SIGN_FLIP",
+			mutantType: mutator.FloatSignFlip,
+		},
+		{
+			name:       "CONTEXT_REPLACE",
+			expected:   "CONTEXT_REPLACE",
+			mutantType: mutator.ContextReplace,
+		},
+		{
+			name:       "REMOVE_GO_STMT",
+			expected:   "REMOVE_GO_STMT",
+			mutantType: mutator.RemoveGoStmt,
+		},
+		{
+			name:       "REMOVE_ELSE",
+			expected:   "REMOVE_ELSE",
+			mutantType: mutator.RemoveElse,
+		},
+		{
+			name:       "COLLECTION_ELEMENT",
+			expected:   "COLLECTION_ELEMENT",
+			mutantType: mutator.CollectionElement,
+		},
+		{
+			name:       "STRICTNESS_TOGGLE",
+			expected:   "STRICTNESS_TOGGLE",
+			mutantType: mutator.StrictnessToggle,
+		},
+		{
+			name:       "REMOVE_DEFAULT_CASE",
+			expected:   "REMOVE_DEFAULT_CASE",
+			mutantType: mutator.RemoveDefaultCase,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -142,3 +239,73 @@ func TestTypeString(t *testing.T) {
 		})
 	}
 }
+
+func TestNewType(t *testing.T) {
+	mt := mutator.NewType("CUSTOM_MUTANT")
+
+	if mt.String() != "CUSTOM_MUTANT" {
+		t.Errorf("expected %q, got %q", "CUSTOM_MUTANT", mt.String())
+	}
+
+	found := false
+	for _, m := range mutator.Types {
+		if m == mt {
+			found = true
+		}
+		if m != mt && m.String() == mt.String() {
+			t.Errorf("expected %q not to clash with %q", mt, m)
+		}
+	}
+	if !found {
+		t.Errorf("expected %q to be in Types", mt)
+	}
+}
+
+func TestParseFingerprint(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fp       string
+		wantPos  token.Position
+		wantType string
+		wantErr  error
+	}{
+		{
+			name:     "it parses a fingerprint",
+			fp:       "pkg/file.go:20:8:ARITHMETIC_BASE",
+			wantPos:  token.Position{Filename: "pkg/file.go", Line: 20, Column: 8},
+			wantType: "ARITHMETIC_BASE",
+		},
+		{
+			name:     "it parses a file name with colons",
+			fp:       "C:/pkg/file.go:20:8:ARITHMETIC_BASE",
+			wantPos:  token.Position{Filename: "C:/pkg/file.go", Line: 20, Column: 8},
+			wantType: "ARITHMETIC_BASE",
+		},
+		{
+			name:    "it fails without the column",
+			fp:      "file.go:20:ARITHMETIC_BASE",
+			wantErr: mutator.ErrInvalidFingerprint,
+		},
+		{
+			name:    "it fails with a line which is not a number",
+			fp:      "file.go:a:8:ARITHMETIC_BASE",
+			wantErr: mutator.ErrInvalidFingerprint,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pos, typeName, err := mutator.ParseFingerprint(tc.fp)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if pos != tc.wantPos || typeName != tc.wantType {
+				t.Errorf("expected %s %s, got %s %s", tc.wantPos, tc.wantType, pos, typeName)
+			}
+			if tc.wantErr == nil && mutator.NewFingerprint(pos, typeName) != tc.fp {
+				t.Errorf("expected the fingerprint to be built back as %q", tc.fp)
+			}
+		})
+	}
+}
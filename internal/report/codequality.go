@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io"
+
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// OutputFormatCodeQuality is the output-format that writes the LIVED
+// mutants as a GitLab Code Quality report.
+const OutputFormatCodeQuality = "codequality"
+
+// codeQualityCheck is the check_name of the issues, the same for all of
+// them since GitLab groups the issues by fingerprint.
+const codeQualityCheck = "gremlins"
+
+type codeQualityIssue struct {
+	Description string              `json:"description"`
+	CheckName   string              `json:"check_name"`
+	Fingerprint string              `json:"fingerprint"`
+	Severity    string              `json:"severity"`
+	Location    codeQualityLocation `json:"location"`
+}
+
+type codeQualityLocation struct {
+	Path  string           `json:"path"`
+	Lines codeQualityLines `json:"lines"`
+}
+
+type codeQualityLines struct {
+	Begin int `json:"begin"`
+}
+
+// codeQualitySeverities maps the severities of the mutator types on the
+// ones of GitLab. The mutants without a severity are major.
+var codeQualitySeverities = map[string]string{
+	SeverityHigh:   "critical",
+	SeverityMedium: "major",
+	SeverityLow:    "minor",
+}
+
+// codeQualityReport writes the LIVED mutants on w as a GitLab Code Quality
+// report, one issue per mutant, sorted by position. The fingerprint of an
+// issue is the one of its mutant, so that GitLab can tell the new LIVED
+// mutants of a merge request from the ones already on the target branch.
+func (r *reportStatus) codeQualityReport(w io.Writer) error {
+	issues := make([]codeQualityIssue, 0, r.lived)
+	for _, fName := range r.sortedFiles() {
+		for _, m := range sortedMutations(r.files[fName]) {
+			if m.Status != mutator.Lived.String() {
+				continue
+			}
+			severity, ok := codeQualitySeverities[m.Severity]
+			if !ok {
+				severity = "major"
+			}
+			pos := token.Position{Filename: fName, Line: m.Line, Column: m.Column}
+			issues = append(issues, codeQualityIssue{
+				Description: fmt.Sprintf("%s mutant LIVED at %s", m.Type, pos),
+				CheckName:   codeQualityCheck,
+				Fingerprint: mutator.NewFingerprint(pos, m.Type),
+				Severity:    severity,
+				Location: codeQualityLocation{
+					Path:  fName,
+					Lines: codeQualityLines{Begin: m.Line},
+				},
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(issues)
+}
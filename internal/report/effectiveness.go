@@ -0,0 +1,117 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"sort"
+
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// typeEffectiveness holds the results of the mutants of a mutator.Type.
+// The informative results are the ones that tell something about the test
+// suite: KILLED, LIVED and TIMED OUT.
+type typeEffectiveness struct {
+	mutantType mutator.Type
+	total      int
+	killed     int
+	lived      int
+	timedOut   int
+}
+
+func (e typeEffectiveness) informative() int {
+	return e.killed + e.lived + e.timedOut
+}
+
+func (e typeEffectiveness) informativeRate() float64 {
+	if e.total == 0 {
+		return 0
+	}
+
+	return float64(e.informative()) / float64(e.total) * 100
+}
+
+func (e typeEffectiveness) killRate() float64 {
+	if e.killed+e.lived == 0 {
+		return 0
+	}
+
+	return float64(e.killed) / float64(e.killed+e.lived) * 100
+}
+
+// effectivenessRanking ranks the mutator.Type of the mutants by their rate of
+// informative results, then by their kill rate.
+func effectivenessRanking(mutants []mutator.Mutator) []typeEffectiveness {
+	var ranking []typeEffectiveness
+	idx := make(map[mutator.Type]int)
+	for _, m := range mutants {
+		i, ok := idx[m.Type()]
+		if !ok {
+			i = len(ranking)
+			idx[m.Type()] = i
+			ranking = append(ranking, typeEffectiveness{mutantType: m.Type()})
+		}
+		e := &ranking[i]
+		e.total++
+		switch m.Status() {
+		case mutator.Killed:
+			e.killed++
+		case mutator.Lived:
+			e.lived++
+		case mutator.TimedOut:
+			e.timedOut++
+		}
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if ranking[i].informativeRate() != ranking[j].informativeRate() {
+			return ranking[i].informativeRate() > ranking[j].informativeRate()
+		}
+
+		return ranking[i].killRate() > ranking[j].killRate()
+	})
+
+	return ranking
+}
+
+func (r *reportStatus) effectivenessReport() {
+	if r.killed+r.lived+r.timedOut == 0 {
+		return
+	}
+	log.Infoln("")
+	log.Infof("Mutator effectiveness:\n")
+	for _, e := range r.effectiveness {
+		log.Infof("%s: %d/%d informative (%.2f%%), kill rate %.2f%%\n",
+			e.mutantType, e.informative(), e.total, e.informativeRate(), e.killRate())
+	}
+}
+
+func (r *reportStatus) mutatorEffectiveness() []internal.MutatorEffectiveness {
+	var res []internal.MutatorEffectiveness
+	for _, e := range r.effectiveness {
+		res = append(res, internal.MutatorEffectiveness{
+			Type:            e.mutantType.String(),
+			Mutants:         e.total,
+			Informative:     e.informative(),
+			InformativeRate: e.informativeRate(),
+			KillRate:        e.killRate(),
+		})
+	}
+
+	return res
+}
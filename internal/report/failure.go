@@ -0,0 +1,89 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-gremlins/gremlins/internal/execution"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// failureMutantsNr is the number of the mutants listed when a threshold is
+// missed.
+const failureMutantsNr = 10
+
+// thresholdFailure reports the missed threshold along with the mutants to
+// look at first: the LIVED ones for the efficacy, and the NOT COVERED ones
+// for the mutant coverage. The returned error wraps the execution.ExitError
+// with the actual and the expected values.
+func (r *reportStatus) thresholdFailure(et execution.ErrorType, value, threshold float64) error {
+	status, name := mutator.Lived, "test efficacy"
+	if et == execution.MutantCoverageThreshold {
+		status, name = mutator.NotCovered, "mutant coverage"
+	}
+	log.Infoln("")
+	log.Infof("The %s of %.2f%% is not above the threshold of %.2f%%, start from these %s mutants:\n", name, value, threshold, status)
+	mutants, more := r.mutantsByStatus(status, failureMutantsNr)
+	for _, m := range mutants {
+		log.Infof("  %s\n", m)
+	}
+	if more > 0 {
+		log.Infof("  and %d more\n", more)
+	}
+
+	return fmt.Errorf("%w: %.2f%% <= %.2f%%", execution.NewExitErr(et), value, threshold)
+}
+
+// mutantsByStatus returns up to n mutants with the given status, as
+// "file:line:column TYPE" and in source order, and the number of the ones
+// left out.
+func (r *reportStatus) mutantsByStatus(status mutator.Status, n int) ([]string, int) {
+	fileNames := make([]string, 0, len(r.files))
+	for fName := range r.files {
+		fileNames = append(fileNames, fName)
+	}
+	sort.Strings(fileNames)
+
+	var mutants []string
+	var more int
+	for _, fName := range fileNames {
+		mutations := append(r.files[fName][:0:0], r.files[fName]...)
+		sort.SliceStable(mutations, func(i, j int) bool {
+			if mutations[i].Line != mutations[j].Line {
+				return mutations[i].Line < mutations[j].Line
+			}
+
+			return mutations[i].Column < mutations[j].Column
+		})
+		for _, m := range mutations {
+			if m.Status != status.String() {
+				continue
+			}
+			if len(mutants) == n {
+				more++
+
+				continue
+			}
+			mutants = append(mutants, fmt.Sprintf("%s:%d:%d %s", fName, m.Line, m.Column, m.Type))
+		}
+	}
+
+	return mutants, more
+}
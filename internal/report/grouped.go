@@ -0,0 +1,97 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// GroupByType is the group-by mode that reports the mutants collapsed by
+// mutator.Type and file, instead of one line per mutant.
+const GroupByType = "type"
+
+// groupedStatuses is the order in which the statuses of the grouped mutants
+// are reported.
+var groupedStatuses = []mutator.Status{
+	mutator.Killed,
+	mutator.Lived,
+	mutator.TimedOut,
+	mutator.NotViable,
+	mutator.NotCovered,
+	mutator.NoTests,
+	mutator.Skipped,
+	mutator.Runnable,
+}
+
+func isGrouped() bool {
+	return configuration.Get[string](configuration.UnleashGroupByKey) == GroupByType
+}
+
+// groupedReport logs the mutants as a tree of mutator.Type, then file, with
+// the count of each status:
+//
+//	ARITHMETIC_BASE (3)
+//	    file1.go: KILLED 2, LIVED 1
+//
+// The types and the files are sorted by name.
+func (r *reportStatus) groupedReport() {
+	// type -> file -> status -> count
+	groups := make(map[string]map[string]map[string]int)
+	totals := make(map[string]int)
+	for fName, mutations := range r.files {
+		for _, m := range mutations {
+			if groups[m.Type] == nil {
+				groups[m.Type] = make(map[string]map[string]int)
+			}
+			if groups[m.Type][fName] == nil {
+				groups[m.Type][fName] = make(map[string]int)
+			}
+			groups[m.Type][fName][m.Status]++
+			totals[m.Type]++
+		}
+	}
+
+	for _, mt := range sortedKeys(groups) {
+		log.Infof("%s (%d)\n", mt, totals[mt])
+		files := groups[mt]
+		for _, fName := range sortedKeys(files) {
+			var counts []string
+			for _, s := range groupedStatuses {
+				if c := files[fName][s.String()]; c > 0 {
+					counts = append(counts, fmt.Sprintf("%s %d", colorStatus(s), c))
+				}
+			}
+			log.Infof("    %s: %s\n", fName, strings.Join(counts, ", "))
+		}
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"sort"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// fileHotspot is a file with NOT COVERED mutants, which would be tested
+// if the file had tests reaching them.
+type fileHotspot struct {
+	fileName   string
+	notCovered int
+}
+
+// hotspotRanking returns the n files with the most NOT COVERED mutants,
+// ranked by their number and then by their name. It tells where to add
+// tests first.
+func hotspotRanking(files map[string][]internal.Mutation, n int) []fileHotspot {
+	var ranking []fileHotspot
+	for fName, mutations := range files {
+		h := fileHotspot{fileName: fName}
+		for _, m := range mutations {
+			if m.Status == mutator.NotCovered.String() {
+				h.notCovered++
+			}
+		}
+		if h.notCovered > 0 {
+			ranking = append(ranking, h)
+		}
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].notCovered != ranking[j].notCovered {
+			return ranking[i].notCovered > ranking[j].notCovered
+		}
+
+		return ranking[i].fileName < ranking[j].fileName
+	})
+	if len(ranking) > n {
+		ranking = ranking[:n]
+	}
+
+	return ranking
+}
+
+func hotspotsNr() int {
+	return configuration.Get[int](configuration.UnleashHotspotsKey)
+}
+
+func (r *reportStatus) hotspotsReport() {
+	if len(r.hotspots) == 0 {
+		return
+	}
+	log.Infoln("")
+	log.Infof("Mutation hotspots:\n")
+	for _, h := range r.hotspots {
+		log.Infof("%s: %d NOT COVERED\n", h.fileName, h.notCovered)
+	}
+}
+
+func (r *reportStatus) outputHotspots() []internal.OutputHotspot {
+	if len(r.hotspots) == 0 {
+		return nil
+	}
+	hotspots := make([]internal.OutputHotspot, 0, len(r.hotspots))
+	for _, h := range r.hotspots {
+		hotspots = append(hotspots, internal.OutputHotspot{Filename: h.fileName, NotCovered: h.notCovered})
+	}
+
+	return hotspots
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"html/template"
+	"io"
+
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Gremlins report{{ with .Module }} - {{ . }}{{ end }}</title>
+</head>
+<body>
+<h1>Gremlins report{{ with .Module }} - {{ . }}{{ end }}</h1>
+{{- range .Files }}
+<h2>{{ .Filename }}</h2>
+<table>
+<tr><th>Position</th><th>Type</th><th>Status</th></tr>
+{{- range .Mutations }}
+<tr><td>{{ .Line }}:{{ .Column }}</td><td>{{ .Type }}</td><td>{{ .Status }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+// htmlReport writes the mutants on w as an HTML page, with a table of the
+// mutants of each file sorted by position.
+func (r *reportStatus) htmlReport(w io.Writer) error {
+	data := struct {
+		Module string
+		Files  []internal.OutputFile
+	}{Module: r.module}
+	for _, fName := range r.sortedFiles() {
+		data.Files = append(data.Files, internal.OutputFile{Filename: fName, Mutations: sortedMutations(r.files[fName])})
+	}
+
+	return htmlTemplate.Execute(w, data)
+}
@@ -29,6 +29,30 @@ type OutputResult struct {
 	MutantsNotCovered int          `json:"mutants_not_covered"`
 	ElapsedTime       float64      `json:"elapsed_time"`
 	MutatorStatistics MutatorType  `json:"mutator_statistics"`
+
+	MutatorEffectiveness []MutatorEffectiveness `json:"mutator_effectiveness,omitempty"`
+	Sample               float64                `json:"sample,omitempty"`
+	ExcludedMutants      int                    `json:"excluded_mutants,omitempty"`
+	MutantsPotential     int                    `json:"mutants_potential,omitempty"`
+	PotentialTested      float64                `json:"potential_tested,omitempty"`
+	Throughput           float64                `json:"throughput,omitempty"`
+	Packages             []OutputPackage        `json:"packages,omitempty"`
+	Hotspots             []OutputHotspot        `json:"hotspots,omitempty"`
+}
+
+// OutputHotspot represents a file with NOT COVERED mutants in the
+// OutputResult data structure.
+type OutputHotspot struct {
+	Filename   string `json:"file_name"`
+	NotCovered int    `json:"not_covered"`
+}
+
+// OutputPackage represents the tests of a package in the OutputResult data
+// structure.
+type OutputPackage struct {
+	Package   string `json:"package"`
+	TestFiles int    `json:"test_files"`
+	TestFuncs int    `json:"test_funcs"`
 }
 
 // OutputFile represents a single file in the OutputResult data structure.
@@ -39,10 +63,28 @@ type OutputFile struct {
 
 // Mutation represents a single mutation in the OutputResult data structure.
 type Mutation struct {
-	Type   string `json:"type"`
-	Status string `json:"status"`
-	Line   int    `json:"line"`
-	Column int    `json:"column"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	Offset     int    `json:"offset,omitempty"`
+	SubReason  string `json:"sub_reason,omitempty"`
+	InInit     bool   `json:"in_init,omitempty"`
+	Function   string `json:"function,omitempty"`
+	Severity   string `json:"severity,omitempty"`
+	Tags       string `json:"tags,omitempty"`
+	Diff       string `json:"diff,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+}
+
+// MutatorEffectiveness represents the results of a mutator type in the
+// OutputResult data structure, ranked by the rate of informative results.
+type MutatorEffectiveness struct {
+	Type            string  `json:"type"`
+	Mutants         int     `json:"mutants"`
+	Informative     int     `json:"informative"`
+	InformativeRate float64 `json:"informative_rate"`
+	KillRate        float64 `json:"kill_rate"`
 }
 
 // MutatorType contains the list of all supported mutator types.
@@ -58,4 +100,22 @@ type MutatorType struct {
 	InvertLoopCtrl           int `json:"invert_loop_ctrl,omitempty"`
 	InvertNegatives          int `json:"invert_negatives,omitempty"`
 	RemoveSelfAssignments    int `json:"remove_self_assignments,omitempty"`
+	DropAppendArg            int `json:"drop_append_arg,omitempty"`
+	InvertErrorCheck         int `json:"invert_error_check,omitempty"`
+	NilCheckInvert           int `json:"nil_check_invert,omitempty"`
+	MinMaxSwap               int `json:"min_max_swap,omitempty"`
+	DropStructField          int `json:"drop_struct_field,omitempty"`
+	ContinueToReturn         int `json:"continue_to_return,omitempty"`
+	RangeCountBoundary       int `json:"range_count_boundary,omitempty"`
+	DropLogicalOperand       int `json:"drop_logical_operand,omitempty"`
+	SliceBoundary            int `json:"slice_boundary,omitempty"`
+	SwitchCaseValue          int `json:"switch_case_value,omitempty"`
+	InjectEarlyReturn        int `json:"inject_early_return,omitempty"`
+	FloatSignFlip            int `json:"float_sign_flip,omitempty"`
+	ContextReplace           int `json:"context_replace,omitempty"`
+	RemoveGoStmt             int `json:"remove_go_stmt,omitempty"`
+	RemoveElse               int `json:"remove_else,omitempty"`
+	CollectionElement        int `json:"collection_element,omitempty"`
+	StrictnessToggle         int `json:"strictness_toggle,omitempty"`
+	RemoveDefaultCase        int `json:"remove_default_case,omitempty"`
 }
@@ -10,11 +10,25 @@ import (
 
 type Filter = map[mutator.Status]struct{}
 
-var ErrInvalidFilter = errors.New("invalid statuses filter, only 'lctkvsr' letters allowed")
+var ErrInvalidFilter = errors.New("invalid statuses filter, only 'lctkvsrn' letters allowed")
 
 // MutantLogger prints mutant statuses based on filter and verbosity flags.
+//
+// CallingDir is the folder, relative to the module root, to which the
+// positions of the mutants are relative, and Module is the name of the
+// module, which prefixes them with the import paths.
 type MutantLogger struct {
 	Filter
+	CallingDir string
+	Module     string
+
+	// CoverageNotGathered tells that the coverage has not been gathered, so
+	// the RUNNABLE mutants are not known to be covered.
+	CoverageNotGathered bool
+
+	// grouped tells that the mutants are reported grouped at the end of the
+	// run, instead of one line per mutant.
+	grouped bool
 }
 
 func NewLogger() MutantLogger {
@@ -25,19 +39,23 @@ func NewLogger() MutantLogger {
 	}
 
 	return MutantLogger{
-		Filter: f,
+		Filter:  f,
+		grouped: isGrouped(),
 	}
 }
 
 func (l MutantLogger) Mutant(m mutator.Mutator) {
+	if l.grouped {
+		return
+	}
 	if l.Filter == nil {
-		Mutant(m)
+		logMutant(m, l.Module, l.CallingDir, l.CoverageNotGathered)
 
 		return
 	}
 
 	if _, ok := l.Filter[m.Status()]; ok {
-		Mutant(m)
+		logMutant(m, l.Module, l.CallingDir, l.CoverageNotGathered)
 	}
 }
 
@@ -64,6 +82,8 @@ func ParseFilter(s string) (Filter, error) {
 			result[mutator.Skipped] = struct{}{}
 		case 'r':
 			result[mutator.Runnable] = struct{}{}
+		case 'n':
+			result[mutator.NoTests] = struct{}{}
 		default:
 			return nil, ErrInvalidFilter
 		}
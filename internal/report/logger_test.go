@@ -42,11 +42,17 @@ func Test_parseFilter(t *testing.T) {
 				mutator.Runnable: struct{}{},
 			},
 		},
+		{
+			filter: "n",
+			want: report.Filter{
+				mutator.NoTests: struct{}{},
+			},
+		},
 		{
 			filter: "",
 		},
 		{
-			filter: "lnc",
+			filter: "lxc",
 			want:   nil,
 			err:    report.ErrInvalidFilter,
 		},
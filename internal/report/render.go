@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// ErrUnknownFormat is returned by Render when the format is not a known one.
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// Formats are the formats a report can be rendered in by Render.
+var Formats = []string{"html", "junit", "tap"}
+
+// Render reads a previous JSON output file and writes it on w in the given
+// format, without running the mutants again.
+func Render(w io.Writer, from, format string) error {
+	var render func(*reportStatus, io.Writer) error
+	switch format {
+	case "html":
+		render = (*reportStatus).htmlReport
+	case "junit":
+		render = (*reportStatus).junitReport
+	case "tap":
+		render = (*reportStatus).tapReport
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	f, err := os.ReadFile(from)
+	if err != nil {
+		return fmt.Errorf("impossible to read the report: %w", err)
+	}
+	var result internal.OutputResult
+	if err := json.Unmarshal(f, &result); err != nil {
+		return fmt.Errorf("impossible to parse the report: %w", err)
+	}
+
+	rep := &reportStatus{
+		files:  make(map[string][]internal.Mutation, len(result.Files)),
+		module: result.GoModule,
+	}
+	for _, file := range result.Files {
+		rep.files[file.Filename] = append(rep.files[file.Filename], file.Mutations...)
+	}
+
+	return render(rep, w)
+}
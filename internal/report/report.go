@@ -18,7 +18,13 @@ package report
 
 import (
 	"encoding/json"
+	"go/token"
+	"io"
 	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -40,69 +46,203 @@ var (
 	fgHiYellow = color.New(color.FgYellow).SprintFunc()
 )
 
+// slowestMutantsNr is the number of slowest mutants shown in the report.
+const slowestMutantsNr = 5
+
 // Results contains the list of mutator.Mutator to be reported
 // and the time it took to discover and test them.
 type Results struct {
 	Module  string
 	Mutants []mutator.Mutator
 	Elapsed time.Duration
+
+	// CallingDir is the folder, relative to the module root, to which the
+	// positions of the mutants are relative.
+	CallingDir string
+
+	// Sample is the fraction of the covered mutants tested, if the run
+	// has been sampled.
+	Sample float64
+
+	// ExcludedMutants is the number of mutants found in the excluded files,
+	// if they have been checked.
+	ExcludedMutants int
+
+	// PotentialMutants is the number of all the mutants found in the
+	// files, including the ones of the disabled types.
+	PotentialMutants int
+
+	// MutantTags holds the build tag set of the run that reported each
+	// mutant, by fingerprint, when running with a tag matrix.
+	MutantTags map[string]string
+
+	// Packages holds the tests found in the packages of the module, by
+	// import path, to give context to their efficacy.
+	Packages map[string]PackageTests
+
+	// CoverageNotGathered tells that the coverage has not been gathered,
+	// with no-coverage or an empty profile, so the mutation coverage is
+	// unknown.
+	CoverageNotGathered bool
+}
+
+// PackageTests is the number of test files and Test functions of a package.
+type PackageTests struct {
+	TestFiles int
+	TestFuncs int
 }
 
 type reportStatus struct {
 	files map[string][]internal.Mutation
 
-	elapsed *durafmt.Durafmt
-	module  string
+	elapsed    *durafmt.Durafmt
+	module     string
+	callingDir string
 
 	killed     int
 	lived      int
 	timedOut   int
 	notCovered int
+	noTests    int
 	skipped    int
 	notViable  int
 	runnable   int
 
 	mutatorStatistics internal.MutatorType
+	slowest           []mutator.Mutator
+	effectiveness     []typeEffectiveness
+	suspicion         []fileSuspicion
+	hotspots          []fileHotspot
 
 	tEfficacy float64
 	mCovered  float64
+	nvRatio   float64
+	sample    float64
+
+	// covNotGathered tells that mCovered is unknown, as the coverage has
+	// not been gathered.
+	covNotGathered bool
+
+	excludedMutants  int
+	potentialMutants int
+	packages         map[string]PackageTests
+	potentialTested  float64
+	throughput       float64
+
+	// livedBySeverity counts the LIVED mutants of the types with a
+	// configured severity.
+	livedBySeverity map[string]int
 }
 
+const likelyInfiniteLoop = "likely-infinite-loop"
+
+// suspectKill is the sub reason of the KILLED mutants whose tests fail
+// without the mutation as well.
+const suspectKill = "suspect-kill"
+
+// sourceUnchanged is the sub reason of the SKIPPED mutants that the
+// self-check found not to change the source.
+const sourceUnchanged = "source-unchanged"
+
+// inInit marks in the log the mutants in the init functions.
+const inInit = "in-init"
+
+// coverageNotGathered is the sub reason of the RUNNABLE mutants when the
+// coverage has not been gathered, so that they aren't mistaken for covered.
+const coverageNotGathered = "coverage-not-gathered"
+
 func newReport(results Results) (*reportStatus, bool) {
 	if len(results.Mutants) == 0 {
 
 		return nil, false
 	}
 	rep := &reportStatus{
-		module:  results.Module,
-		elapsed: durafmt.Parse(results.Elapsed).LimitFirstN(2),
+		module:     results.Module,
+		callingDir: results.CallingDir,
+		elapsed:    durafmt.Parse(results.Elapsed).LimitFirstN(2),
+		sample:     results.Sample,
+
+		covNotGathered:   results.CoverageNotGathered,
+		excludedMutants:  results.ExcludedMutants,
+		potentialMutants: results.PotentialMutants,
+		packages:         results.Packages,
 	}
 	rep.files = make(map[string][]internal.Mutation)
 	for _, m := range results.Mutants {
-		rep.files[m.Position().Filename] = append(rep.files[m.Position().Filename], internal.Mutation{
-			Line:   m.Position().Line,
-			Column: m.Position().Column,
-			Type:   m.Type().String(),
-			Status: m.Status().String(),
+		fName := position(m, results.Module, results.CallingDir).Filename
+		rep.files[fName] = append(rep.files[fName], internal.Mutation{
+			Line:       m.Position().Line,
+			Column:     m.Position().Column,
+			Offset:     m.Position().Offset,
+			Type:       m.Type().String(),
+			Status:     m.Status().String(),
+			SubReason:  subReason(m, results.CoverageNotGathered),
+			InInit:     m.InInit(),
+			Function:   m.Function(),
+			Severity:   severity(m.Type()),
+			Tags:       results.MutantTags[mutator.NewFingerprint(m.Position(), m.Type().String())],
+			Diff:       livedDiff(m),
+			DurationMs: m.Duration().Milliseconds(),
 		})
 
 		reportMutationStatus(m, rep)
+		reportSeverity(m, rep)
 		reportMutatorType(m, rep)
 	}
+	rep.slowest = slowestMutants(results.Mutants, slowestMutantsNr)
+	if isSortedBySuspicion() {
+		rep.suspicion = suspicionRanking(rep.files)
+	}
+	if n := hotspotsNr(); n > 0 {
+		rep.hotspots = hotspotRanking(rep.files, n)
+	}
 	if !rep.isDryRun() {
+		rep.effectiveness = effectivenessRanking(results.Mutants)
 		if rep.killed > 0 {
 			rep.tEfficacy = float64(rep.killed) / float64(rep.killed+rep.lived) * 100
 		}
-		if rep.killed+rep.lived > 0 {
+		if rep.killed+rep.lived > 0 && !rep.covNotGathered {
 			rep.mCovered = float64(rep.killed+rep.lived) / float64(rep.killed+rep.lived+rep.notCovered) * 100
 		}
-	} else if rep.runnable > 0 {
+		if rep.notViable > 0 {
+			rep.nvRatio = float64(rep.notViable) / float64(rep.killed+rep.lived+rep.timedOut+rep.notViable) * 100
+		}
+		if results.Elapsed > 0 {
+			rep.throughput = float64(rep.tested()) / results.Elapsed.Seconds()
+		}
+	} else if rep.runnable > 0 && !rep.covNotGathered {
 		rep.mCovered = float64(rep.runnable) / float64(rep.runnable+rep.notCovered) * 100
 	}
+	if rep.potentialMutants > 0 {
+		rep.potentialTested = float64(rep.tested()) / float64(rep.potentialMutants) * 100
+	}
 
 	return rep, true
 }
 
+// tested returns the number of the mutants that have been tested, or that
+// would be tested in a dry run.
+func (r *reportStatus) tested() int {
+	return r.killed + r.lived + r.timedOut + r.notViable + r.runnable
+}
+
+func slowestMutants(mutants []mutator.Mutator, n int) []mutator.Mutator {
+	var timed []mutator.Mutator
+	for _, m := range mutants {
+		if m.Duration() > 0 {
+			timed = append(timed, m)
+		}
+	}
+	sort.SliceStable(timed, func(i, j int) bool {
+		return timed[i].Duration() > timed[j].Duration()
+	})
+	if len(timed) > n {
+		timed = timed[:n]
+	}
+
+	return timed
+}
+
 func reportMutationStatus(m mutator.Mutator, rep *reportStatus) {
 	switch m.Status() {
 	case mutator.Killed:
@@ -111,6 +251,8 @@ func reportMutationStatus(m mutator.Mutator, rep *reportStatus) {
 		rep.lived++
 	case mutator.NotCovered:
 		rep.notCovered++
+	case mutator.NoTests:
+		rep.noTests++
 	case mutator.Skipped:
 		rep.skipped++
 	case mutator.TimedOut:
@@ -146,6 +288,42 @@ func reportMutatorType(m mutator.Mutator, rep *reportStatus) {
 		rep.mutatorStatistics.InvertNegatives++
 	case mutator.RemoveSelfAssignments:
 		rep.mutatorStatistics.RemoveSelfAssignments++
+	case mutator.DropAppendArg:
+		rep.mutatorStatistics.DropAppendArg++
+	case mutator.InvertErrorCheck:
+		rep.mutatorStatistics.InvertErrorCheck++
+	case mutator.NilCheckInvert:
+		rep.mutatorStatistics.NilCheckInvert++
+	case mutator.MinMaxSwap:
+		rep.mutatorStatistics.MinMaxSwap++
+	case mutator.DropStructField:
+		rep.mutatorStatistics.DropStructField++
+	case mutator.ContinueToReturn:
+		rep.mutatorStatistics.ContinueToReturn++
+	case mutator.RangeCountBoundary:
+		rep.mutatorStatistics.RangeCountBoundary++
+	case mutator.DropLogicalOperand:
+		rep.mutatorStatistics.DropLogicalOperand++
+	case mutator.SliceBoundary:
+		rep.mutatorStatistics.SliceBoundary++
+	case mutator.SwitchCaseValue:
+		rep.mutatorStatistics.SwitchCaseValue++
+	case mutator.InjectEarlyReturn:
+		rep.mutatorStatistics.InjectEarlyReturn++
+	case mutator.FloatSignFlip:
+		rep.mutatorStatistics.FloatSignFlip++
+	case mutator.ContextReplace:
+		rep.mutatorStatistics.ContextReplace++
+	case mutator.RemoveGoStmt:
+		rep.mutatorStatistics.RemoveGoStmt++
+	case mutator.RemoveElse:
+		rep.mutatorStatistics.RemoveElse++
+	case mutator.CollectionElement:
+		rep.mutatorStatistics.CollectionElement++
+	case mutator.StrictnessToggle:
+		rep.mutatorStatistics.StrictnessToggle++
+	case mutator.RemoveDefaultCase:
+		rep.mutatorStatistics.RemoveDefaultCase++
 	}
 }
 
@@ -154,50 +332,135 @@ func (*reportStatus) isDryRun() bool {
 }
 
 func (r *reportStatus) reportFindings() {
-	if r.isDryRun() {
-		r.dryRunReport()
-	} else {
-		r.fullRunReport()
+	jsonStdout := configuration.Get[bool](configuration.UnleashJSONStdoutKey)
+	tapStdout := isTAPStdout()
+	if !jsonStdout && !tapStdout {
+		if isGrouped() {
+			r.groupedReport()
+		}
+		if r.isDryRun() {
+			r.dryRunReport()
+		} else {
+			r.fullRunReport()
+		}
+	}
+	r.severityReport()
+	if r.excludedMutants > 0 {
+		log.Warnf("%d mutants found in the excluded files\n", r.excludedMutants)
 	}
-	r.fileReport()
-}
-
-func (r *reportStatus) fileReport() {
 	if output := configuration.Get[string](configuration.UnleashOutputKey); output != "" {
-		files := make([]internal.OutputFile, 0, len(r.files))
-		for fName, mutations := range r.files {
-			of := internal.OutputFile{Filename: fName}
-			of.Mutations = append(of.Mutations, mutations...)
-			files = append(files, of)
+		r.outputFileReport(output)
+	}
+	if jsonStdout {
+		if err := r.fileReport(os.Stdout); err != nil {
+			log.Errorf("impossible to write on stdout: %s\n", err)
 		}
+	}
+	if tapStdout {
+		if err := r.tapReport(os.Stdout); err != nil {
+			log.Errorf("impossible to write on stdout: %s\n", err)
+		}
+	}
+}
 
-		result := internal.OutputResult{
-			GoModule:          r.module,
-			TestEfficacy:      r.tEfficacy,
-			MutationsCoverage: r.mCovered,
-			MutantsTotal:      r.lived + r.killed + r.notViable,
-			MutantsKilled:     r.killed,
-			MutantsLived:      r.lived,
-			MutantsNotViable:  r.notViable,
-			MutantsNotCovered: r.notCovered,
-			ElapsedTime:       r.elapsed.Duration().Seconds(),
-			MutatorStatistics: r.mutatorStatistics,
-			Files:             files,
+// OutputFormatJSON is the default output-format, which writes the results
+// as JSON.
+const OutputFormatJSON = "json"
+
+// outputFileReport writes the results on the output file, in the format
+// set by output-format.
+func (r *reportStatus) outputFileReport(output string) {
+	write := r.fileReport
+	switch configuration.Get[string](configuration.UnleashOutputFormatKey) {
+	case OutputFormatSQLite:
+		if err := r.sqliteReport(output, time.Now().Add(-r.elapsed.Duration())); err != nil {
+			log.Errorf("impossible to write the database: %s\n", err)
 		}
 
-		jsonResult, _ := json.Marshal(result)
-		f, err := os.Create(output)
-		if err != nil {
-			log.Errorf("impossible to write file: %s\n", err)
+		return
+	case OutputFormatCodeQuality:
+		write = r.codeQualityReport
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		log.Errorf("impossible to write file: %s\n", err)
+
+		return
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+	if err := write(f); err != nil {
+		log.Errorf("impossible to write file: %s\n", err)
+	}
+}
+
+// fileReport writes the machine readable results as JSON on w.
+func (r *reportStatus) fileReport(w io.Writer) error {
+	fNames := make([]string, 0, len(r.files))
+	if r.suspicion != nil {
+		for _, s := range r.suspicion {
+			fNames = append(fNames, s.fileName)
 		}
-		defer func(f *os.File) {
-			_ = f.Close()
-		}(f)
-		if _, err := f.Write(jsonResult); err != nil {
-			log.Errorf("impossible to write file: %s\n", err)
+	} else {
+		for fName := range r.files {
+			fNames = append(fNames, fName)
 		}
+	}
+	files := make([]internal.OutputFile, 0, len(r.files))
+	for _, fName := range fNames {
+		of := internal.OutputFile{Filename: fName}
+		of.Mutations = append(of.Mutations, r.files[fName]...)
+		files = append(files, of)
+	}
 
+	result := internal.OutputResult{
+		GoModule:          r.module,
+		TestEfficacy:      r.tEfficacy,
+		MutationsCoverage: r.mCovered,
+		MutantsTotal:      r.lived + r.killed + r.notViable,
+		MutantsKilled:     r.killed,
+		MutantsLived:      r.lived,
+		MutantsNotViable:  r.notViable,
+		MutantsNotCovered: r.notCovered,
+		ElapsedTime:       r.elapsed.Duration().Seconds(),
+		MutatorStatistics: r.mutatorStatistics,
+		Files:             files,
+
+		MutatorEffectiveness: r.mutatorEffectiveness(),
+		Sample:               r.sample,
+		ExcludedMutants:      r.excludedMutants,
+		MutantsPotential:     r.potentialMutants,
+		PotentialTested:      r.potentialTested,
+		Throughput:           r.throughput,
+		Packages:             r.outputPackages(),
+		Hotspots:             r.outputHotspots(),
 	}
+
+	jsonResult, _ := json.Marshal(result)
+	_, err := w.Write(jsonResult)
+
+	return err
+}
+
+// outputPackages returns the tests of the packages, sorted by import path.
+func (r *reportStatus) outputPackages() []internal.OutputPackage {
+	if len(r.packages) == 0 {
+		return nil
+	}
+	pkgs := make([]internal.OutputPackage, 0, len(r.packages))
+	for name, tests := range r.packages {
+		pkgs = append(pkgs, internal.OutputPackage{
+			Package:   name,
+			TestFiles: tests.TestFiles,
+			TestFuncs: tests.TestFuncs,
+		})
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		return pkgs[i].Package < pkgs[j].Package
+	})
+
+	return pkgs
 }
 
 func (r *reportStatus) dryRunReport() {
@@ -206,7 +469,12 @@ func (r *reportStatus) dryRunReport() {
 	log.Infoln("")
 	log.Infof("Dry run completed in %s\n", r.elapsed.String())
 	log.Infof("Runnable: %s, Not covered: %s\n", runnable, notCovered)
-	log.Infof("Mutator coverage: %.2f%%\n", r.mCovered)
+	r.noTestsReport()
+	r.coverageReport()
+	r.potentialReport()
+	r.sampleReport()
+	r.suspicionReport()
+	r.hotspotsReport()
 }
 
 func (r *reportStatus) fullRunReport() {
@@ -220,10 +488,71 @@ func (r *reportStatus) fullRunReport() {
 	log.Infof("Mutation testing completed in %s\n", r.elapsed.String())
 	log.Infof("Killed: %s, Lived: %s, Not covered: %s\n", killed, lived, notCovered)
 	log.Infof("Timed out: %s, Not viable: %s, Skipped: %s\n", timedOut, notViable, skipped)
+	r.noTestsReport()
 	log.Infof("Test efficacy: %.2f%%\n", r.tEfficacy)
+	r.coverageReport()
+	r.throughputReport()
+	r.potentialReport()
+	r.sampleReport()
+	r.effectivenessReport()
+	r.suspicionReport()
+	r.hotspotsReport()
+	r.slowestReport()
+}
+
+func (r *reportStatus) coverageReport() {
+	if r.covNotGathered {
+		log.Infof("Mutator coverage: not gathered\n")
+
+		return
+	}
 	log.Infof("Mutator coverage: %.2f%%\n", r.mCovered)
 }
 
+func (r *reportStatus) potentialReport() {
+	if r.potentialMutants == 0 {
+		return
+	}
+	log.Infof("Potential mutants: %d, tested: %.2f%%\n", r.potentialMutants, r.potentialTested)
+}
+
+// throughputReport reports the tested mutants per second, to gauge the
+// effect of the workers and timeout settings.
+func (r *reportStatus) throughputReport() {
+	if r.throughput == 0 {
+		return
+	}
+	log.Infof("Throughput: %.2f mutants/s\n", r.throughput)
+}
+
+// noTestsReport reports the mutants of the packages without test files,
+// which are not tested.
+func (r *reportStatus) noTestsReport() {
+	if r.noTests == 0 {
+		return
+	}
+	log.Infof("No tests: %s, their packages have no test files\n", fgHiYellow(r.noTests))
+}
+
+func (r *reportStatus) sampleReport() {
+	if r.sample == 0 {
+		return
+	}
+	log.Infof("Sampled: %.2f%% of the covered mutants, the others are SKIPPED\n", r.sample*100)
+}
+
+func (r *reportStatus) slowestReport() {
+	if len(r.slowest) == 0 {
+		return
+	}
+	log.Infoln("")
+	log.Infof("Slowest mutants:\n")
+	for _, m := range r.slowest {
+		d := durafmt.Parse(m.Duration()).LimitFirstN(2)
+		log.Infof("%s %s at %s\n", d, m.Type(), position(m, r.module, r.callingDir))
+	}
+}
+
 func (r *reportStatus) assess(tEfficacy, rCoverage float64) error {
 	if r.isDryRun() {
 		return nil
@@ -234,14 +563,56 @@ func (r *reportStatus) assess(tEfficacy, rCoverage float64) error {
 		et = float64(configuration.Get[int](configuration.UnleashThresholdEfficacyKey))
 	}
 	if et > 0 && tEfficacy <= et {
-		return execution.NewExitErr(execution.EfficacyThreshold)
+		return r.thresholdFailure(execution.EfficacyThreshold, tEfficacy, et)
 	}
 	ct := configuration.Get[float64](configuration.UnleashThresholdMCoverageKey)
 	if ct == 0 {
 		ct = float64(configuration.Get[int](configuration.UnleashThresholdMCoverageKey))
 	}
-	if ct > 0 && rCoverage <= ct {
-		return execution.NewExitErr(execution.MutantCoverageThreshold)
+	if ct > 0 && r.covNotGathered {
+		log.Warnf("the coverage has not been gathered, the mutant coverage threshold is not checked\n")
+	} else if ct > 0 && rCoverage <= ct {
+		return r.thresholdFailure(execution.MutantCoverageThreshold, rCoverage, ct)
+	}
+
+	if r.lived > 0 && configuration.Get[bool](configuration.UnleashFailFastKey) {
+		return execution.NewExitErr(execution.FailFast)
+	}
+
+	if err := r.assessStrict(); err != nil {
+		return err
+	}
+
+	if err := r.assessExcluded(); err != nil {
+		return err
+	}
+
+	return r.assessSeverity()
+}
+
+// assessExcluded fails the run when the excluded files contain mutants and
+// fail-on-excluded is set.
+func (r *reportStatus) assessExcluded() error {
+	if r.excludedMutants > 0 && configuration.Get[bool](configuration.UnleashFailOnExcludedKey) {
+		return execution.NewExitErr(execution.ExcludedMutants)
+	}
+
+	return nil
+}
+
+// assessStrict fails the run when strict mode is enabled and the NOT VIABLE
+// ratio is above threshold. A high number of NOT VIABLE mutants usually
+// signals a flaky build or a bug in Gremlins itself.
+func (r *reportStatus) assessStrict() error {
+	if !configuration.Get[bool](configuration.UnleashStrictKey) {
+		return nil
+	}
+	nt := configuration.Get[float64](configuration.UnleashThresholdNotViableKey)
+	if nt == 0 {
+		nt = float64(configuration.Get[int](configuration.UnleashThresholdNotViableKey))
+	}
+	if r.nvRatio > nt {
+		return execution.NewExitErr(execution.NotViableThreshold)
 	}
 
 	return nil
@@ -263,26 +634,125 @@ func Do(results Results) error {
 	return rep.assess(rep.tEfficacy, rep.mCovered)
 }
 
+// Summary is the compact summary of a run, as sent to the webhook.
+type Summary struct {
+	GoModule          string  `json:"go_module"`
+	TestEfficacy      float64 `json:"test_efficacy"`
+	MutationsCoverage float64 `json:"mutations_coverage"`
+	MutantsLived      int     `json:"mutants_lived"`
+}
+
+// Summarize returns the Summary of the Results received, and false if there
+// are no results to summarize.
+func Summarize(results Results) (Summary, bool) {
+	rep, ok := newReport(results)
+	if !ok {
+		return Summary{}, false
+	}
+
+	return Summary{
+		GoModule:          rep.module,
+		TestEfficacy:      rep.tEfficacy,
+		MutationsCoverage: rep.mCovered,
+		MutantsLived:      rep.lived,
+	}, true
+}
+
 // Mutant logs a mutator.Mutator.
 // It reports the mutant.Status, the mutator.Type and its position.
 // This function uses the log package in gremlins to write to the
 // chosen io.Writer, so it is necessary to call log.Init before
 // the report generation.
 func Mutant(m mutator.Mutator) {
-	status := m.Status().String()
-	switch m.Status() {
+	logMutant(m, "", "", false)
+}
+
+func logMutant(m mutator.Mutator, module, callingDir string, covNotGathered bool) {
+	status := colorStatus(m.Status())
+	pos := position(m, module, callingDir).String()
+	if fn := m.Function(); fn != "" && configuration.Get[bool](configuration.UnleashLogFunctionKey) {
+		pos += " in " + fn
+	}
+	var reasons []string
+	if reason := subReason(m, covNotGathered); reason != "" {
+		reasons = append(reasons, reason)
+	}
+	if m.InInit() {
+		reasons = append(reasons, inInit)
+	}
+	if len(reasons) > 0 {
+		pos += " (" + strings.Join(reasons, ", ") + ")"
+	}
+	log.Infof("%s%s %s at %s\n", padding(m.Status()), status, m.Type(), pos)
+	if diff := livedDiff(m); diff != "" {
+		log.Infof("%s", diff)
+	}
+}
+
+func colorStatus(s mutator.Status) string {
+	switch s {
 	case mutator.Killed, mutator.Runnable:
-		status = fgHiGreen(m.Status())
+		return fgHiGreen(s)
 	case mutator.Lived:
-		status = fgRed(m.Status())
-	case mutator.NotCovered:
-		status = fgHiYellow(m.Status())
+		return fgRed(s)
+	case mutator.NotCovered, mutator.NoTests:
+		return fgHiYellow(s)
 	case mutator.TimedOut:
-		status = fgGreen(m.Status())
+		return fgGreen(s)
 	case mutator.NotViable, mutator.Skipped:
-		status = fgHiBlack(m.Status())
+		return fgHiBlack(s)
 	}
-	log.Infof("%s%s %s at %s\n", padding(m.Status()), status, m.Type(), m.Position())
+
+	return s.String()
+}
+
+// livedDiff returns the diff of the change made by a LIVED mutant, if the
+// diffs are configured to be reported.
+func livedDiff(m mutator.Mutator) string {
+	if m.Status() != mutator.Lived || !configuration.Get[bool](configuration.UnleashLivedDiffKey) {
+		return ""
+	}
+
+	return m.Diff()
+}
+
+// position returns the token.Position of the mutator.Mutator. The file names
+// of the mutants are relative to the calling dir, and they are made relative
+// to the module root, or prefixed by the import path of their package, if
+// configured.
+func position(m mutator.Mutator, module, callingDir string) token.Position {
+	pos := m.Position()
+	switch {
+	case configuration.Get[bool](configuration.UnleashImportPathsKey) && module != "":
+		pos.Filename = path.Join(module, filepath.ToSlash(filepath.Join(callingDir, pos.Filename)))
+	case configuration.Get[bool](configuration.UnleashModuleRootPathsKey):
+		pos.Filename = filepath.ToSlash(filepath.Join(callingDir, pos.Filename))
+	}
+
+	return pos
+}
+
+// subReason returns the detail of the mutator.Status of the mutator.Mutator,
+// if any. A TIMED OUT mutant on a position controlling a loop most likely
+// caused an infinite loop, and a KILLED mutant whose tests fail without the
+// mutation too has a suspect kill. A RUNNABLE mutant is not known to be
+// covered when the coverage has not been gathered. A SKIPPED mutant may
+// have been found not to change the source by the self-check.
+func subReason(m mutator.Mutator, covNotGathered bool) string {
+	if m.Status() == mutator.TimedOut && m.NodeKind() == mutator.LoopControlNode {
+		return likelyInfiniteLoop
+	}
+	if m.Status() == mutator.Killed && m.SuspectKill() {
+		return suspectKill
+	}
+	if m.Status() == mutator.Runnable && covNotGathered {
+		return coverageNotGathered
+	}
+	if m.Status() == mutator.Skipped && m.Unchanged() {
+		return sourceUnchanged
+	}
+
+	return ""
 }
 
 func padding(s mutator.Status) string {
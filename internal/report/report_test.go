@@ -18,12 +18,17 @@ package report_test
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"fmt"
 	"go/token"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -70,7 +75,12 @@ func TestReport(t *testing.T) {
 				"Killed: 1, Lived: 1, Not covered: 1\n" +
 				"Timed out: 1, Not viable: 1, Skipped: 1\n" +
 				"Test efficacy: 50.00%\n" +
-				"Mutator coverage: 66.67%\n",
+				"Mutator coverage: 66.67%\n" +
+				"Throughput: 0.03 mutants/s\n" +
+				"\n" +
+				"Mutator effectiveness:\n" +
+				"CONDITIONALS_NEGATION: 2/3 informative (66.67%), kill rate 50.00%\n" +
+				"CONDITIONALS_BOUNDARY: 1/3 informative (33.33%), kill rate 0.00%\n",
 		},
 		{
 			name: "reports findings with no coverage",
@@ -97,7 +107,12 @@ func TestReport(t *testing.T) {
 				"Killed: 0, Lived: 0, Not covered: 0\n" +
 				"Timed out: 2, Not viable: 0, Skipped: 0\n" +
 				"Test efficacy: 0.00%\n" +
-				coverageLine,
+				coverageLine +
+				"Throughput: 0.01 mutants/s\n" +
+				"\n" +
+				"Mutator effectiveness:\n" +
+				"CONDITIONALS_NEGATION: 1/1 informative (100.00%), kill rate 0.00%\n" +
+				"CONDITIONALS_BOUNDARY: 1/1 informative (100.00%), kill rate 0.00%\n",
 		},
 		{
 			name:    "reports nothing if no result",
@@ -191,6 +206,128 @@ func newPosition(filename string, col, line int) token.Position {
 	}
 }
 
+func TestReportCoverageNotGathered(t *testing.T) {
+	notCovered := stubMutant{status: mutator.NotCovered, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 3, 10)}
+	testCases := []struct {
+		name         string
+		notGathered  bool
+		mutants      []mutator.Mutator
+		wantLog      string
+		wantCoverage float64
+		wantErr      bool
+	}{
+		{
+			name:        "it reports the coverage as not gathered and skips the threshold",
+			notGathered: true,
+			mutants: []mutator.Mutator{
+				stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			},
+			wantLog: "Mutator coverage: not gathered\n",
+		},
+		{
+			name: "it reports the real coverage and checks the threshold",
+			mutants: []mutator.Mutator{
+				stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+				notCovered,
+			},
+			wantLog:      "Mutator coverage: 50.00%\n",
+			wantCoverage: 50,
+			wantErr:      true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			outFile := filepath.Join(t.TempDir(), "findings.json")
+			viper.Set(configuration.UnleashOutputKey, outFile)
+			viper.Set(configuration.UnleashThresholdMCoverageKey, 80.0)
+			defer viper.Reset()
+			out := &bytes.Buffer{}
+			eOut := &bytes.Buffer{}
+			log.Init(out, eOut)
+			defer log.Reset()
+
+			data := report.Results{
+				Mutants:             tc.mutants,
+				Elapsed:             2 * time.Minute,
+				CoverageNotGathered: tc.notGathered,
+			}
+			err := report.Do(data)
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error: %v, got %v", tc.wantErr, err)
+			}
+			if !strings.Contains(out.String(), tc.wantLog) {
+				t.Errorf("expected %q in the log, got:\n%s", tc.wantLog, out.String())
+			}
+			if tc.notGathered && !strings.Contains(eOut.String(), "threshold is not checked") {
+				t.Errorf("expected a warning about the skipped threshold, got %q", eOut.String())
+			}
+			file, _ := os.ReadFile(outFile)
+			var got internal.OutputResult
+			if err := json.Unmarshal(file, &got); err != nil {
+				t.Fatal("impossible to unmarshal results")
+			}
+			if got.MutationsCoverage != tc.wantCoverage {
+				t.Errorf("expected the mutations coverage to be %v, got %v", tc.wantCoverage, got.MutationsCoverage)
+			}
+		})
+	}
+}
+
+func TestCoverageNotGatheredLabel(t *testing.T) {
+	viper.Set(configuration.UnleashDryRunKey, true)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	runnable := stubMutant{status: mutator.Runnable, mutantType: mutator.ConditionalsNegation, position: fakePosition}
+	notCovered := stubMutant{status: mutator.NotCovered, mutantType: mutator.ConditionalsBoundary, position: fakePosition}
+	logger := report.MutantLogger{CoverageNotGathered: true}
+	logger.Mutant(runnable)
+	logger.Mutant(notCovered)
+
+	got := out.String()
+	if !strings.Contains(got, "RUNNABLE CONDITIONALS_NEGATION at aFolder/aFile.go:12:3 (coverage-not-gathered)\n") {
+		t.Errorf("expected the runnable mutant to be labelled, got:\n%s", got)
+	}
+	if !strings.Contains(got, "NOT COVERED CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n") {
+		t.Errorf("expected the not covered mutant not to be labelled, got:\n%s", got)
+	}
+}
+
+func TestReportSample(t *testing.T) {
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.Skipped, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+		},
+		Elapsed: 2 * time.Minute,
+		Sample:  0.1,
+	}
+	_ = report.Do(data)
+
+	if got := out.String(); !strings.Contains(got, "Sampled: 10.00% of the covered mutants, the others are SKIPPED\n") {
+		t.Errorf("expected the sample to be reported, got:\n%s", got)
+	}
+	file, _ := os.ReadFile(output)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	if got.Sample != 0.1 {
+		t.Errorf("expected sample 0.1 in output file, got %v", got.Sample)
+	}
+}
+
 func TestAssessment(t *testing.T) {
 	testCases := []struct {
 		value       any
@@ -250,6 +387,13 @@ func TestAssessment(t *testing.T) {
 			value:       51,
 			expectError: true,
 		},
+		// Fail fast
+		{
+			name:        "fail-fast with LIVED mutants",
+			confKey:     configuration.UnleashFailFastKey,
+			value:       true,
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -293,6 +437,304 @@ func TestAssessment(t *testing.T) {
 	}
 }
 
+func TestThresholdFailureMessage(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("b.go", 4, 5)},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsNegation, position: newPosition("a.go", 2, 9)},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("a.go", 7, 3)},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("a.go", 1, 1)},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.InvertNegatives, position: newPosition("c.go", 3, 8)},
+	}
+	testCases := []struct {
+		name     string
+		confKey  string
+		value    float64
+		wantLog  string
+		wantErr  string
+		wantCode int
+	}{
+		{
+			name:    "it lists the LIVED mutants below the efficacy-threshold",
+			confKey: configuration.UnleashThresholdEfficacyKey,
+			value:   60,
+			wantLog: "The test efficacy of 25.00% is not above the threshold of 60.00%, start from these LIVED mutants:\n" +
+				"  a.go:3:7 ARITHMETIC_BASE\n" +
+				"  a.go:9:2 CONDITIONALS_NEGATION\n" +
+				"  b.go:5:4 ARITHMETIC_BASE\n",
+			wantErr:  "below efficacy-threshold: 25.00% <= 60.00%",
+			wantCode: 10,
+		},
+		{
+			name:    "it lists the NOT COVERED mutants below the coverage-threshold",
+			confKey: configuration.UnleashThresholdMCoverageKey,
+			value:   90,
+			wantLog: "The mutant coverage of 80.00% is not above the threshold of 90.00%, start from these NOT COVERED mutants:\n" +
+				"  c.go:8:3 INVERT_NEGATIVES\n",
+			wantErr:  "below mutant coverage-threshold: 80.00% <= 90.00%",
+			wantCode: 11,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			log.Init(out, &bytes.Buffer{})
+			defer log.Reset()
+			viper.Set(tc.confKey, tc.value)
+			defer viper.Reset()
+
+			err := report.Do(report.Results{Mutants: mutants, Elapsed: 1 * time.Minute})
+
+			var exitErr *execution.ExitError
+			if !errors.As(err, &exitErr) || exitErr.ExitCode() != tc.wantCode {
+				t.Fatalf("expected exit error with code %d, got %v", tc.wantCode, err)
+			}
+			if err.Error() != tc.wantErr {
+				t.Errorf("expected error %q, got %q", tc.wantErr, err.Error())
+			}
+			if got := out.String(); !strings.Contains(got, tc.wantLog) {
+				t.Errorf("expected the failure message:\n%s\ngot:\n%s", tc.wantLog, got)
+			}
+		})
+	}
+}
+
+func TestStrictAssessment(t *testing.T) {
+	testCases := []struct {
+		threshold   any
+		name        string
+		strict      bool
+		expectError bool
+	}{
+		{
+			name:        "not-viable > not-viable-threshold",
+			strict:      true,
+			threshold:   float64(20),
+			expectError: true,
+		},
+		{
+			name:        "not-viable <= not-viable-threshold",
+			strict:      true,
+			threshold:   float64(25),
+			expectError: false,
+		},
+		{
+			name:        "not-viable > not-viable-threshold as int",
+			strict:      true,
+			threshold:   20,
+			expectError: true,
+		},
+		{
+			name:        "not-viable-threshold == 0 fails on any not viable",
+			strict:      true,
+			threshold:   float64(0),
+			expectError: true,
+		},
+		{
+			name:        "not-viable-threshold is ignored if not strict",
+			strict:      false,
+			threshold:   float64(20),
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			log.Init(&bytes.Buffer{}, &bytes.Buffer{})
+			defer log.Reset()
+
+			viper.Set(configuration.UnleashStrictKey, tc.strict)
+			viper.Set(configuration.UnleashThresholdNotViableKey, tc.threshold)
+			defer viper.Reset()
+
+			// Always 25%
+			mutants := []mutator.Mutator{
+				stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+				stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+				stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+				stubMutant{status: mutator.NotViable, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			}
+			data := report.Results{
+				Mutants: mutants,
+				Elapsed: 1 * time.Minute,
+			}
+
+			err := report.Do(data)
+
+			if !tc.expectError {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				return
+			}
+			var exitErr *execution.ExitError
+			if !errors.As(err, &exitErr) {
+				t.Fatal("expected err to be ExitError")
+			}
+			if exitErr.ExitCode() != 12 {
+				t.Errorf("expected exit code to be 12, got %d", exitErr.ExitCode())
+			}
+		})
+	}
+}
+
+func TestExcludedMutantsAssessment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		excluded    int
+		fail        bool
+		wantWarning bool
+		expectError bool
+	}{
+		{
+			name:        "it warns about the mutants in the excluded files",
+			excluded:    3,
+			wantWarning: true,
+		},
+		{
+			name:        "it fails if configured",
+			excluded:    3,
+			fail:        true,
+			wantWarning: true,
+			expectError: true,
+		},
+		{
+			name: "it doesn't warn nor fail without mutants in the excluded files",
+			fail: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, eOut)
+			defer log.Reset()
+			viper.Set(configuration.UnleashFailOnExcludedKey, tc.fail)
+			defer viper.Reset()
+
+			data := report.Results{
+				Mutants: []mutator.Mutator{
+					stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+				},
+				Elapsed:         1 * time.Minute,
+				ExcludedMutants: tc.excluded,
+			}
+
+			err := report.Do(data)
+
+			gotWarning := strings.Contains(eOut.String(), "WARNING: 3 mutants found in the excluded files")
+			if gotWarning != tc.wantWarning {
+				t.Errorf("expected warning to be %v, got %q", tc.wantWarning, eOut.String())
+			}
+			if !tc.expectError {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				return
+			}
+			var exitErr *execution.ExitError
+			if !errors.As(err, &exitErr) {
+				t.Fatal("expected err to be ExitError")
+			}
+			if exitErr.ExitCode() != 14 {
+				t.Errorf("expected exit code to be 14, got %d", exitErr.ExitCode())
+			}
+		})
+	}
+}
+
+func TestSeverityAssessment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		severity    string
+		wantWarning string
+		expectError bool
+	}{
+		{
+			name:        "it fails if LIVED mutants are of high severity",
+			severity:    "high",
+			wantWarning: "WARNING: 1 LIVED mutants of high severity",
+			expectError: true,
+		},
+		{
+			name:        "it warns if LIVED mutants are of low severity",
+			severity:    "LOW",
+			wantWarning: "WARNING: 1 LIVED mutants of low severity",
+		},
+		{
+			name:     "it doesn't warn nor fail without a valid severity",
+			severity: "critical",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			eOut := &bytes.Buffer{}
+			log.Init(&bytes.Buffer{}, eOut)
+			defer log.Reset()
+			output := filepath.Join(t.TempDir(), "findings.json")
+			viper.Set(configuration.UnleashOutputKey, output)
+			viper.Set(configuration.MutantTypeSeverityKey(mutator.ConditionalsNegation), tc.severity)
+			defer viper.Reset()
+
+			data := report.Results{
+				Mutants: []mutator.Mutator{
+					stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+					stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+					stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: fakePosition},
+				},
+				Elapsed: 1 * time.Minute,
+			}
+
+			err := report.Do(data)
+
+			if tc.wantWarning != "" && !strings.Contains(eOut.String(), tc.wantWarning) {
+				t.Errorf("expected warning %q, got %q", tc.wantWarning, eOut.String())
+			}
+			if tc.wantWarning == "" && eOut.Len() != 0 {
+				t.Errorf("expected no warning, got %q", eOut.String())
+			}
+
+			file, _ := os.ReadFile(output)
+			var got internal.OutputResult
+			if err := json.Unmarshal(file, &got); err != nil {
+				t.Fatal("impossible to unmarshal results")
+			}
+			wantSeverity := strings.ToLower(tc.severity)
+			if tc.wantWarning == "" {
+				wantSeverity = ""
+			}
+			for _, m := range got.Files[0].Mutations {
+				want := wantSeverity
+				if m.Type != mutator.ConditionalsNegation.String() {
+					want = ""
+				}
+				if m.Severity != want {
+					t.Errorf("expected severity %q for %s, got %q", want, m.Type, m.Severity)
+				}
+			}
+
+			if !tc.expectError {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				return
+			}
+			var exitErr *execution.ExitError
+			if !errors.As(err, &exitErr) {
+				t.Fatal("expected err to be ExitError")
+			}
+			if exitErr.ExitCode() != 15 {
+				t.Errorf("expected exit code to be 15, got %d", exitErr.ExitCode())
+			}
+		})
+	}
+}
+
 func TestMutantLog(t *testing.T) {
 	out := &bytes.Buffer{}
 	defer out.Reset()
@@ -414,39 +856,934 @@ func TestReportToFile(t *testing.T) {
 	})
 }
 
-func notWriteableDir(t *testing.T) (string, func()) {
-	t.Helper()
-	tmp := t.TempDir()
-	outPath, _ := os.MkdirTemp(tmp, "test-")
-	_ = os.Chmod(outPath, 0000)
-	clean := os.Chmod
-	if runtime.GOOS == "windows" {
-		_ = acl.Chmod(outPath, 0000)
-		clean = acl.Chmod
+func TestReportToSQLite(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10), function: "parse"},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20), duration: time.Second},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: newPosition("it's.go", 7, 40)},
 	}
-
-	return outPath, func() {
-		_ = clean(outPath, 0700)
+	data := report.Results{
+		Module:  "example.com/go/module",
+		Mutants: mutants,
+		Elapsed: 2 * time.Minute,
 	}
-}
-
-func sortOutputFile(x, y internal.OutputFile) bool {
-	return x.Filename < y.Filename
-}
-
-func sortMutation(x, y internal.Mutation) bool {
-	if x.Line == y.Line {
+	output := filepath.Join(t.TempDir(), "gremlins.db")
+	viper.Set(configuration.UnleashOutputKey, output)
+	viper.Set(configuration.UnleashOutputFormatKey, report.OutputFormatSQLite)
+	defer viper.Reset()
 
-		return x.Column < y.Column
+	for i := 0; i < 2; i++ {
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
 	}
 
-	return x.Line < y.Line
-}
-
-type stubMutant struct {
-	position   token.Position
-	status     mutator.Status
+	db, err := sql.Open("sqlite", output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	query := func(q string) string {
+		t.Helper()
+		rows, err := db.Query(q)
+		if err != nil {
+			t.Fatalf("query failed: %s", err)
+		}
+		defer func() { _ = rows.Close() }()
+		cols, _ := rows.Columns()
+		b := &strings.Builder{}
+		for rows.Next() {
+			values := make([]any, len(cols))
+			ptrs := make([]any, len(cols))
+			for i := range values {
+				ptrs[i] = &values[i]
+			}
+			if err := rows.Scan(ptrs...); err != nil {
+				t.Fatal(err)
+			}
+			for i, v := range values {
+				if i > 0 {
+					b.WriteString("|")
+				}
+				_, _ = fmt.Fprint(b, v)
+			}
+			b.WriteString("\n")
+		}
+
+		return b.String()
+	}
+	wantRuns := "1|example.com/go/module|50|66.66666666666666|2|1|1|0|1|120\n" +
+		"2|example.com/go/module|50|66.66666666666666|2|1|1|0|1|120\n"
+	gotRuns := query("SELECT id, go_module, test_efficacy, mutations_coverage, mutants_total, mutants_killed, mutants_lived, mutants_not_viable, mutants_not_covered, elapsed_time FROM runs ORDER BY id")
+	if !cmp.Equal(gotRuns, wantRuns) {
+		t.Errorf(cmp.Diff(wantRuns, gotRuns))
+	}
+	wantMutants := "file1.go|10|3|CONDITIONALS_NEGATION|KILLED|parse|0\n" +
+		"file1.go|20|8|ARITHMETIC_BASE|LIVED||1000\n" +
+		"it's.go|40|7|INCREMENT_DECREMENT|NOT COVERED||0\n"
+	gotMutants := query("SELECT file, line, col, type, status, function, duration_ms FROM mutants WHERE run_id = 2 ORDER BY file, line")
+	if !cmp.Equal(gotMutants, wantMutants) {
+		t.Errorf(cmp.Diff(wantMutants, gotMutants))
+	}
+
+	data.CoverageNotGathered = true
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+	if got := query("SELECT id FROM runs WHERE mutations_coverage IS NULL"); got != "3\n" {
+		t.Errorf("expected only the run without coverage to have a NULL coverage, got %q", got)
+	}
+}
+
+func TestReportToCodeQuality(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10)},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: newPosition("file2.go", 7, 40)},
+		stubMutant{status: mutator.Lived, mutantType: mutator.InvertNegatives, position: newPosition("file2.go", 5, 12)},
+	}
+	data := report.Results{
+		Module:  "example.com/go/module",
+		Mutants: mutants,
+		Elapsed: 2 * time.Minute,
+	}
+	output := filepath.Join(t.TempDir(), "gl-code-quality-report.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	viper.Set(configuration.UnleashOutputFormatKey, report.OutputFormatCodeQuality)
+	viper.Set(configuration.MutantTypeSeverityKey(mutator.InvertNegatives), report.SeverityLow)
+	defer viper.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	f, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type issue struct {
+		CheckName   string `json:"check_name"`
+		Fingerprint string `json:"fingerprint"`
+		Severity    string `json:"severity"`
+		Location    struct {
+			Path  string `json:"path"`
+			Lines struct {
+				Begin int `json:"begin"`
+			} `json:"lines"`
+		} `json:"location"`
+	}
+	var got []issue
+	if err := json.Unmarshal(f, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []issue{
+		{CheckName: "gremlins", Fingerprint: "file1.go:20:8:ARITHMETIC_BASE", Severity: "major"},
+		{CheckName: "gremlins", Fingerprint: "file2.go:12:5:INVERT_NEGATIVES", Severity: "minor"},
+	}
+	want[0].Location.Path = "file1.go"
+	want[0].Location.Lines.Begin = 20
+	want[1].Location.Path = "file2.go"
+	want[1].Location.Lines.Begin = 12
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestReportToStdout(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10), duration: time.Second},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20), duration: time.Second},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: newPosition("file2.go", 7, 40)},
+	}
+	data := report.Results{
+		Module:  "example.com/go/module",
+		Mutants: mutants,
+		Elapsed: (2 * time.Minute) + (22 * time.Second),
+		Packages: map[string]report.PackageTests{
+			"example.com/go/module/b": {},
+			"example.com/go/module/a": {TestFiles: 2, TestFuncs: 5},
+		},
+	}
+	viper.Set(configuration.UnleashJSONStdoutKey, true)
+	defer viper.Reset()
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+	log.Init(w, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+	_ = w.Close()
+	out, _ := io.ReadAll(r)
+
+	var got internal.OutputResult
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("expected only valid JSON on stdout, got %q: %s", out, err)
+	}
+	if got.GoModule != "example.com/go/module" || got.MutantsKilled != 1 || got.MutantsLived != 1 {
+		t.Errorf("unexpected results on stdout: %+v", got)
+	}
+	if len(got.Files) != 2 {
+		t.Errorf("expected 2 files, got %d", len(got.Files))
+	}
+	wantPkgs := []internal.OutputPackage{
+		{Package: "example.com/go/module/a", TestFiles: 2, TestFuncs: 5},
+		{Package: "example.com/go/module/b"},
+	}
+	if !cmp.Equal(got.Packages, wantPkgs) {
+		t.Error(cmp.Diff(wantPkgs, got.Packages))
+	}
+}
+
+func TestReportTAPToStdout(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 20, 8), duration: time.Second},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: newPosition("file2.go", 40, 7)},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 10, 3), duration: time.Second},
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 5, 3), duration: time.Second},
+		stubMutant{status: mutator.NotViable, mutantType: mutator.InvertNegatives, position: newPosition("file2.go", 1, 9)},
+	}
+	data := report.Results{
+		Module:  "example.com/go/module",
+		Mutants: mutants,
+		Elapsed: (2 * time.Minute) + (22 * time.Second),
+	}
+	viper.Set(configuration.UnleashTAPStdoutKey, true)
+	defer viper.Reset()
+
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+	log.Init(w, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+	_ = w.Close()
+	out, _ := io.ReadAll(r)
+
+	want := "TAP version 13\n" +
+		"1..5\n" +
+		"ok 1 - CONDITIONALS_BOUNDARY at file1.go:3:5\n" +
+		"ok 2 - CONDITIONALS_NEGATION at file1.go:3:10\n" +
+		"not ok 3 - ARITHMETIC_BASE at file1.go:8:20 # LIVED\n" +
+		"not ok 4 - INCREMENT_DECREMENT at file2.go:7:40 # NOT COVERED\n" +
+		"ok 5 - INVERT_NEGATIVES at file2.go:9:1 # SKIP NOT VIABLE\n"
+	if !cmp.Equal(string(out), want) {
+		t.Error(cmp.Diff(want, string(out)))
+	}
+}
+
+func TestMutatorEffectiveness(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.InvertNegatives, position: fakePosition},
+		stubMutant{status: mutator.Killed, mutantType: mutator.InvertNegatives, position: fakePosition},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: fakePosition},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: fakePosition},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsBoundary, position: fakePosition},
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsBoundary, position: fakePosition},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: fakePosition},
+		stubMutant{status: mutator.NotViable, mutantType: mutator.IncrementDecrement, position: fakePosition},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: 2 * time.Minute,
+	}
+
+	t.Run("it logs the ranking of the mutators", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		_ = report.Do(data)
+
+		want := "\n" +
+			"Mutator effectiveness:\n" +
+			"CONDITIONALS_BOUNDARY: 2/2 informative (100.00%), kill rate 100.00%\n" +
+			"ARITHMETIC_BASE: 2/2 informative (100.00%), kill rate 50.00%\n" +
+			"INVERT_NEGATIVES: 1/2 informative (50.00%), kill rate 100.00%\n" +
+			"INCREMENT_DECREMENT: 0/2 informative (0.00%), kill rate 0.00%\n"
+		got := out.String()
+
+		if !strings.HasSuffix(got, want) {
+			t.Errorf("expected output to end with the ranking, got:\n%s", got)
+		}
+	})
+
+	t.Run("it writes the ranking on file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "findings.json")
+		viper.Set(configuration.UnleashOutputKey, output)
+		defer viper.Reset()
+
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
+
+		file, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal("file not found")
+		}
+		var got internal.OutputResult
+		if err = json.Unmarshal(file, &got); err != nil {
+			t.Fatal("impossible to unmarshal results")
+		}
+
+		want := []internal.MutatorEffectiveness{
+			{Type: "CONDITIONALS_BOUNDARY", Mutants: 2, Informative: 2, InformativeRate: 100, KillRate: 100},
+			{Type: "ARITHMETIC_BASE", Mutants: 2, Informative: 2, InformativeRate: 100, KillRate: 50},
+			{Type: "INVERT_NEGATIVES", Mutants: 2, Informative: 1, InformativeRate: 50, KillRate: 100},
+			{Type: "INCREMENT_DECREMENT", Mutants: 2, Informative: 0, InformativeRate: 0, KillRate: 0},
+		}
+		if !cmp.Equal(got.MutatorEffectiveness, want) {
+			t.Errorf(cmp.Diff(want, got.MutatorEffectiveness))
+		}
+	})
+}
+
+func TestReportDurations(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10), duration: 1500 * time.Millisecond},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20), duration: 3 * time.Second},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.IncrementDecrement, position: newPosition("file1.go", 7, 40)},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: (2 * time.Minute) + (22 * time.Second),
+	}
+
+	t.Run("it reports the slowest mutants", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		_ = report.Do(data)
+
+		want := "\n" +
+			"Slowest mutants:\n" +
+			"3 seconds ARITHMETIC_BASE at file1.go:20:8\n" +
+			"1 second 500 milliseconds CONDITIONALS_NEGATION at file1.go:10:3\n"
+		got := out.String()
+
+		if !strings.HasSuffix(got, want) {
+			t.Errorf("expected output to end with slowest mutants, got:\n%s", got)
+		}
+	})
+
+	t.Run("it writes the duration of the mutants on file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "findings.json")
+		viper.Set(configuration.UnleashOutputKey, output)
+		defer viper.Reset()
+
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
+
+		file, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal("file not found")
+		}
+		var got internal.OutputResult
+		if err = json.Unmarshal(file, &got); err != nil {
+			t.Fatal("impossible to unmarshal results")
+		}
+
+		want := []internal.Mutation{
+			{Type: "CONDITIONALS_NEGATION", Status: "KILLED", Line: 10, Column: 3, DurationMs: 1500},
+			{Type: "ARITHMETIC_BASE", Status: "LIVED", Line: 20, Column: 8, DurationMs: 3000},
+			{Type: "INCREMENT_DECREMENT", Status: "NOT COVERED", Line: 40, Column: 7},
+		}
+		if len(got.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(got.Files))
+		}
+		if !cmp.Equal(got.Files[0].Mutations, want, cmpopts.SortSlices(sortMutation)) {
+			t.Errorf(cmp.Diff(got.Files[0].Mutations, want))
+		}
+		if !strings.Contains(string(file), `"duration_ms":3000`) {
+			t.Errorf("expected duration_ms field in output, got %s", file)
+		}
+	})
+}
+
+func TestReportLikelyInfiniteLoop(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 3, 10), nodeKind: mutator.LoopControlNode},
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)},
+		stubMutant{status: mutator.Killed, mutantType: mutator.InvertLoopCtrl, position: newPosition("file1.go", 7, 40), nodeKind: mutator.LoopControlNode},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: (2 * time.Minute) + (22 * time.Second),
+	}
+
+	t.Run("it logs the sub-reason of a timed out loop control mutant", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		for _, m := range mutants {
+			report.Mutant(m)
+		}
+
+		want := "" +
+			"   TIMED OUT CONDITIONALS_BOUNDARY at file1.go:10:3 (likely-infinite-loop)\n" +
+			"   TIMED OUT ARITHMETIC_BASE at file1.go:20:8\n" +
+			"      KILLED INVERT_LOOPCTRL at file1.go:40:7\n"
+		got := out.String()
+
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(got, want))
+		}
+	})
+
+	t.Run("it writes the sub-reason on file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "findings.json")
+		viper.Set(configuration.UnleashOutputKey, output)
+		defer viper.Reset()
+
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
+
+		file, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal("file not found")
+		}
+		var got internal.OutputResult
+		if err = json.Unmarshal(file, &got); err != nil {
+			t.Fatal("impossible to unmarshal results")
+		}
+
+		want := []internal.Mutation{
+			{Type: "CONDITIONALS_BOUNDARY", Status: "TIMED OUT", Line: 10, Column: 3, SubReason: "likely-infinite-loop"},
+			{Type: "ARITHMETIC_BASE", Status: "TIMED OUT", Line: 20, Column: 8},
+			{Type: "INVERT_LOOPCTRL", Status: "KILLED", Line: 40, Column: 7},
+		}
+		if len(got.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(got.Files))
+		}
+		if !cmp.Equal(got.Files[0].Mutations, want, cmpopts.SortSlices(sortMutation)) {
+			t.Errorf(cmp.Diff(got.Files[0].Mutations, want))
+		}
+	})
+}
+
+func TestReportSuspectKill(t *testing.T) {
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	report.Mutant(stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 3, 10), suspect: true})
+	report.Mutant(stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)})
+
+	want := "" +
+		"      KILLED ARITHMETIC_BASE at file1.go:10:3 (suspect-kill)\n" +
+		"      KILLED ARITHMETIC_BASE at file1.go:20:8\n"
+	if got := out.String(); !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestReportSourceUnchanged(t *testing.T) {
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	report.Mutant(stubMutant{status: mutator.Skipped, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 3, 10), unchanged: true})
+	report.Mutant(stubMutant{status: mutator.Skipped, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)})
+
+	want := "" +
+		"     SKIPPED ARITHMETIC_BASE at file1.go:10:3 (source-unchanged)\n" +
+		"     SKIPPED ARITHMETIC_BASE at file1.go:20:8\n"
+	if got := out.String(); !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestReportInInit(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.NotViable, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 3, 10), inInit: true},
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 5, 12), nodeKind: mutator.LoopControlNode, inInit: true},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: (2 * time.Minute) + (22 * time.Second),
+	}
+
+	t.Run("it logs the mutants in init", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		for _, m := range mutants {
+			report.Mutant(m)
+		}
+
+		want := "" +
+			"  NOT VIABLE CONDITIONALS_BOUNDARY at file1.go:10:3 (in-init)\n" +
+			"   TIMED OUT CONDITIONALS_NEGATION at file1.go:12:5 (likely-infinite-loop, in-init)\n" +
+			"      KILLED ARITHMETIC_BASE at file1.go:20:8\n"
+		got := out.String()
+
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(got, want))
+		}
+	})
+
+	t.Run("it writes the mutants in init on file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "findings.json")
+		viper.Set(configuration.UnleashOutputKey, output)
+		defer viper.Reset()
+
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
+
+		file, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal("file not found")
+		}
+		var got internal.OutputResult
+		if err = json.Unmarshal(file, &got); err != nil {
+			t.Fatal("impossible to unmarshal results")
+		}
+
+		want := []internal.Mutation{
+			{Type: "CONDITIONALS_BOUNDARY", Status: "NOT VIABLE", Line: 10, Column: 3, InInit: true},
+			{Type: "CONDITIONALS_NEGATION", Status: "TIMED OUT", Line: 12, Column: 5, SubReason: "likely-infinite-loop", InInit: true},
+			{Type: "ARITHMETIC_BASE", Status: "KILLED", Line: 20, Column: 8},
+		}
+		if len(got.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(got.Files))
+		}
+		if !cmp.Equal(got.Files[0].Mutations, want, cmpopts.SortSlices(sortMutation)) {
+			t.Errorf(cmp.Diff(got.Files[0].Mutations, want))
+		}
+	})
+}
+
+func TestReportFunction(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 20, 8), function: "parseConfig"},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 10, 12), function: "parseConfig.func1"},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 5, 30)},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: 2 * time.Minute,
+	}
+
+	t.Run("it logs the function if configured", func(t *testing.T) {
+		viper.Set(configuration.UnleashLogFunctionKey, true)
+		defer viper.Reset()
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		for _, m := range mutants {
+			report.Mutant(m)
+		}
+
+		want := "" +
+			"       LIVED ARITHMETIC_BASE at file1.go:8:20 in parseConfig\n" +
+			"      KILLED CONDITIONALS_NEGATION at file1.go:12:10 in parseConfig.func1\n" +
+			"      KILLED ARITHMETIC_BASE at file1.go:30:5\n"
+		if got := out.String(); !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(got, want))
+		}
+	})
+
+	t.Run("it doesn't log the function by default", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		report.Mutant(mutants[0])
+
+		if got := out.String(); strings.Contains(got, "parseConfig") {
+			t.Errorf("expected no function in the log, got %q", got)
+		}
+	})
+
+	t.Run("it writes the function on file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "findings.json")
+		viper.Set(configuration.UnleashOutputKey, output)
+		defer viper.Reset()
+
+		if err := report.Do(data); err != nil {
+			t.Fatal("error not expected")
+		}
+
+		file, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal("file not found")
+		}
+		var got internal.OutputResult
+		if err = json.Unmarshal(file, &got); err != nil {
+			t.Fatal("impossible to unmarshal results")
+		}
+
+		want := []internal.Mutation{
+			{Type: "ARITHMETIC_BASE", Status: "LIVED", Line: 8, Column: 20, Function: "parseConfig"},
+			{Type: "CONDITIONALS_NEGATION", Status: "KILLED", Line: 12, Column: 10, Function: "parseConfig.func1"},
+			{Type: "ARITHMETIC_BASE", Status: "KILLED", Line: 30, Column: 5},
+		}
+		if len(got.Files) != 1 {
+			t.Fatalf("expected 1 file, got %d", len(got.Files))
+		}
+		if !cmp.Equal(got.Files[0].Mutations, want, cmpopts.SortSlices(sortMutation)) {
+			t.Errorf(cmp.Diff(got.Files[0].Mutations, want))
+		}
+	})
+}
+
+func TestReportOffset(t *testing.T) {
+	pos := token.Position{Filename: "file1.go", Offset: 123, Line: 8, Column: 20}
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: pos},
+		},
+		Elapsed: 2 * time.Minute,
+	}
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	file, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal("file not found")
+	}
+	var got internal.OutputResult
+	if err = json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+
+	if len(got.Files) != 1 || len(got.Files[0].Mutations) != 1 {
+		t.Fatalf("expected 1 mutation, got %+v", got.Files)
+	}
+	if m := got.Files[0].Mutations[0]; m.Offset != pos.Offset {
+		t.Errorf("expected offset %d, got %d", pos.Offset, m.Offset)
+	}
+	if !strings.Contains(string(file), `"offset":123`) {
+		t.Errorf("expected offset field in output, got %s", file)
+	}
+}
+
+func TestImportPaths(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashImportPathsKey, true)
+	viper.Set(configuration.UnleashOutputKey, outFile)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	defer out.Reset()
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	m := stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("sub/file1.go", 8, 20)}
+	data := report.Results{
+		Module:     "example.com/go/module",
+		CallingDir: "pkg",
+		Mutants:    []mutator.Mutator{m},
+	}
+
+	logger := report.NewLogger()
+	logger.CallingDir = data.CallingDir
+	logger.Module = data.Module
+	logger.Mutant(m)
+	if !strings.Contains(out.String(), "LIVED ARITHMETIC_BASE at example.com/go/module/pkg/sub/file1.go:20:8") {
+		t.Errorf("expected import path prefixed path in log, got %q", out.String())
+	}
+
+	if err := report.Do(data); err != nil {
+		t.Fatal(err)
+	}
+	file, _ := os.ReadFile(outFile)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	if len(got.Files) != 1 || got.Files[0].Filename != "example.com/go/module/pkg/sub/file1.go" {
+		t.Fatalf("expected import path prefixed path in output, got %+v", got.Files)
+	}
+
+	fps, err := report.LivedFingerprints(outFile, data.CallingDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fps.Contains(m) {
+		t.Errorf("expected the lived mutant to be retried, got %v", fps)
+	}
+}
+
+func TestModuleRootPaths(t *testing.T) {
+	outFile := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashModuleRootPathsKey, true)
+	viper.Set(configuration.UnleashOutputKey, outFile)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	defer out.Reset()
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	m := stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)}
+	data := report.Results{
+		Module:     "example.com/go/module",
+		CallingDir: "sub/pkg",
+		Mutants:    []mutator.Mutator{m},
+	}
+
+	logger := report.NewLogger()
+	logger.CallingDir = data.CallingDir
+	logger.Mutant(m)
+	if !strings.Contains(out.String(), "LIVED ARITHMETIC_BASE at sub/pkg/file1.go:20:8") {
+		t.Errorf("expected module root relative path in log, got %q", out.String())
+	}
+
+	if err := report.Do(data); err != nil {
+		t.Fatal(err)
+	}
+	file, _ := os.ReadFile(outFile)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	if len(got.Files) != 1 || got.Files[0].Filename != "sub/pkg/file1.go" {
+		t.Fatalf("expected module root relative path in output, got %+v", got.Files)
+	}
+
+	fps, err := report.LivedFingerprints(outFile, data.CallingDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fps.Contains(m) {
+		t.Errorf("expected the lived mutant to be retried, got %v", fps)
+	}
+}
+
+func TestReportLivedDiff(t *testing.T) {
+	const diff = "--- a/file1.go\n+++ b/file1.go\n@@ -1 +1 @@\n-a > b\n+a >= b\n"
+	outFile := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashLivedDiffKey, true)
+	viper.Set(configuration.UnleashOutputKey, outFile)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	defer out.Reset()
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	lived := stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 3, 1), diff: diff}
+	killed := stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 3, 2), diff: diff}
+
+	report.Mutant(lived)
+	report.Mutant(killed)
+	if strings.Count(out.String(), diff) != 1 {
+		t.Errorf("expected the diff to be logged only for the lived mutant, got %q", out.String())
+	}
+
+	out.Reset()
+	inInit := lived
+	inInit.inInit = true
+	report.Mutant(inInit)
+	if !strings.Contains(out.String(), "(in-init)\n"+diff) {
+		t.Errorf("expected the diff to be logged after the reasons, got %q", out.String())
+	}
+
+	if err := report.Do(report.Results{Mutants: []mutator.Mutator{lived, killed}}); err != nil {
+		t.Fatal(err)
+	}
+	file, _ := os.ReadFile(outFile)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	for _, m := range got.Files[0].Mutations {
+		want := ""
+		if m.Status == mutator.Lived.String() {
+			want = diff
+		}
+		if m.Diff != want {
+			t.Errorf("expected %s mutant diff to be %q, got %q", m.Status, want, m.Diff)
+		}
+	}
+}
+
+func TestLivedFingerprints(t *testing.T) {
+	prev := internal.OutputResult{
+		Files: []internal.OutputFile{
+			{
+				Filename: "file1.go",
+				Mutations: []internal.Mutation{
+					{Type: "CONDITIONALS_NEGATION", Status: "KILLED", Line: 10, Column: 3},
+					{Type: "ARITHMETIC_BASE", Status: "LIVED", Line: 20, Column: 8},
+				},
+			},
+			{
+				Filename: "file2.go",
+				Mutations: []internal.Mutation{
+					{Type: "INVERT_LOGICAL", Status: "LIVED", Line: 4, Column: 11},
+					{Type: "INCREMENT_DECREMENT", Status: "NOT COVERED", Line: 40, Column: 7},
+				},
+			},
+		},
+	}
+	f, _ := json.Marshal(prev)
+	path := filepath.Join(t.TempDir(), "findings.json")
+	if err := os.WriteFile(path, f, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("it reads only the lived mutants", func(t *testing.T) {
+		got, err := report.LivedFingerprints(path, ".")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := mutator.Fingerprints{
+			"file1.go:20:8:ARITHMETIC_BASE": {},
+			"file2.go:4:11:INVERT_LOGICAL":  {},
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+
+		lived := stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)}
+		if !got.Contains(lived) {
+			t.Errorf("expected mutant to be retried")
+		}
+		killed := stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10)}
+		if got.Contains(killed) {
+			t.Errorf("expected mutant not to be retried")
+		}
+	})
+
+	t.Run("it fails if the report doesn't exist", func(t *testing.T) {
+		if _, err := report.LivedFingerprints(filepath.Join(t.TempDir(), "missing.json"), "."); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestSuppressedFingerprints(t *testing.T) {
+	t.Run("it reads the suppressed mutants", func(t *testing.T) {
+		viper.Set(configuration.UnleashSuppressKey, []any{"pkg/file1.go:20:8:ARITHMETIC_BASE", "file2.go:4:11:INVERT_LOGICAL"})
+		defer viper.Reset()
+
+		got, err := report.SuppressedFingerprints("example.com/go/module", ".")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := mutator.Fingerprints{
+			"pkg/file1.go:20:8:ARITHMETIC_BASE": {},
+			"file2.go:4:11:INVERT_LOGICAL":      {},
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it makes the paths relative to the calling dir with module root paths", func(t *testing.T) {
+		viper.Set(configuration.UnleashSuppressKey, []string{"pkg/file1.go:20:8:ARITHMETIC_BASE"})
+		viper.Set(configuration.UnleashModuleRootPathsKey, true)
+		defer viper.Reset()
+
+		got, err := report.SuppressedFingerprints("example.com/go/module", "pkg")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := mutator.Fingerprints{"file1.go:20:8:ARITHMETIC_BASE": {}}
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it makes the paths relative to the calling dir with import paths", func(t *testing.T) {
+		viper.Set(configuration.UnleashSuppressKey, []string{"example.com/go/module/pkg/file1.go:20:8:ARITHMETIC_BASE"})
+		viper.Set(configuration.UnleashImportPathsKey, true)
+		defer viper.Reset()
+
+		got, err := report.SuppressedFingerprints("example.com/go/module", "pkg")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := mutator.Fingerprints{"file1.go:20:8:ARITHMETIC_BASE": {}}
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+
+	t.Run("it fails if a fingerprint is not valid", func(t *testing.T) {
+		viper.Set(configuration.UnleashSuppressKey, []string{"file1.go:20:ARITHMETIC_BASE"})
+		defer viper.Reset()
+
+		if _, err := report.SuppressedFingerprints("example.com/go/module", "."); !errors.Is(err, mutator.ErrInvalidFingerprint) {
+			t.Errorf("expected %v, got %v", mutator.ErrInvalidFingerprint, err)
+		}
+	})
+}
+
+func notWriteableDir(t *testing.T) (string, func()) {
+	t.Helper()
+	tmp := t.TempDir()
+	outPath, _ := os.MkdirTemp(tmp, "test-")
+	_ = os.Chmod(outPath, 0000)
+	clean := os.Chmod
+	if runtime.GOOS == "windows" {
+		_ = acl.Chmod(outPath, 0000)
+		clean = acl.Chmod
+	}
+
+	return outPath, func() {
+		_ = clean(outPath, 0700)
+	}
+}
+
+func sortOutputFile(x, y internal.OutputFile) bool {
+	return x.Filename < y.Filename
+}
+
+func sortMutation(x, y internal.Mutation) bool {
+	if x.Line == y.Line {
+
+		return x.Column < y.Column
+	}
+
+	return x.Line < y.Line
+}
+
+type stubMutant struct {
+	position   token.Position
+	status     mutator.Status
 	mutantType mutator.Type
+	duration   time.Duration
+	nodeKind   mutator.NodeKind
+	inInit     bool
+	function   string
+	suspect    bool
+	unchanged  bool
+	diff       string
 }
 
 func (s stubMutant) Type() mutator.Type {
@@ -492,3 +1829,371 @@ func (stubMutant) Apply() error {
 func (stubMutant) Rollback() error {
 	panic("implement me")
 }
+
+func (s stubMutant) Duration() time.Duration {
+	return s.duration
+}
+
+func (stubMutant) SetDuration(_ time.Duration) {
+	panic("implement me")
+}
+
+func (s stubMutant) NodeKind() mutator.NodeKind {
+	return s.nodeKind
+}
+
+func (stubMutant) SetNodeKind(_ mutator.NodeKind) {
+	panic("implement me")
+}
+
+func (s stubMutant) InInit() bool {
+	return s.inInit
+}
+
+func (stubMutant) SetInInit(_ bool) {
+	panic("implement me")
+}
+
+func (s stubMutant) SuspectKill() bool {
+	return s.suspect
+}
+
+func (stubMutant) SetSuspectKill(_ bool) {
+	panic("implement me")
+}
+
+func (s stubMutant) Unchanged() bool {
+	return s.unchanged
+}
+
+func (s stubMutant) Function() string {
+	return s.function
+}
+
+func (stubMutant) SetFunction(_ string) {
+	panic("implement me")
+}
+
+func (s stubMutant) Diff() string {
+	return s.diff
+}
+
+func TestReportPotentialMutants(t *testing.T) {
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+		},
+		Elapsed:          1 * time.Minute,
+		PotentialMutants: 8,
+	}
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	if got := out.String(); !strings.Contains(got, "Potential mutants: 8, tested: 25.00%\n") {
+		t.Errorf("expected the potential mutants to be logged, got:\n%s", got)
+	}
+	file, _ := os.ReadFile(output)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	if got.MutantsPotential != 8 || got.PotentialTested != 25 {
+		t.Errorf("expected 8 potential mutants, 25%% tested, got %d, %v%%", got.MutantsPotential, got.PotentialTested)
+	}
+}
+
+func TestReportThroughput(t *testing.T) {
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.Lived, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.NotViable, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.Skipped, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+		},
+		Elapsed: 2 * time.Second,
+	}
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	if got := out.String(); !strings.Contains(got, "Throughput: 2.50 mutants/s\n") {
+		t.Errorf("expected the throughput to be logged, got:\n%s", got)
+	}
+	file, _ := os.ReadFile(output)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	if got.Throughput != 2.5 {
+		t.Errorf("expected a throughput of 2.5 mutants/s, got %v", got.Throughput)
+	}
+}
+
+func TestReportNoTests(t *testing.T) {
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.NoTests, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			stubMutant{status: mutator.NoTests, mutantType: mutator.ConditionalsBoundary, position: fakePosition},
+		},
+		Elapsed: 2 * time.Second,
+	}
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "No tests: 2, their packages have no test files\n") {
+		t.Errorf("expected the NO TESTS mutants to be counted, got:\n%s", got)
+	}
+	if !strings.Contains(got, "Killed: 1, Lived: 0, Not covered: 0\n") {
+		t.Errorf("expected the NO TESTS mutants not to be counted as LIVED, got:\n%s", got)
+	}
+}
+
+func TestReportSortedBySuspicion(t *testing.T) {
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("killed.go", 3, 10)},
+			stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("lived.go", 3, 10)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("mixed.go", 3, 10)},
+			stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("mixed.go", 3, 11)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("not_covered.go", 3, 10)},
+			stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("not_covered.go", 3, 11)},
+		},
+		Elapsed: 1 * time.Minute,
+	}
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	viper.Set(configuration.UnleashSortByKey, report.SortBySuspicion)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	wantLog := "\n" +
+		"Most suspicious files:\n" +
+		"mixed.go: 1 LIVED, 1 NOT COVERED\n" +
+		"lived.go: 1 LIVED, 0 NOT COVERED\n" +
+		"not_covered.go: 0 LIVED, 1 NOT COVERED\n"
+	if got := out.String(); !strings.Contains(got, wantLog) {
+		t.Errorf("expected the most suspicious files to be logged, got:\n%s", got)
+	}
+	file, _ := os.ReadFile(output)
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	var gotFiles []string
+	for _, f := range got.Files {
+		gotFiles = append(gotFiles, f.Filename)
+	}
+	wantFiles := []string{"mixed.go", "lived.go", "not_covered.go", "killed.go"}
+	if !cmp.Equal(gotFiles, wantFiles) {
+		t.Errorf(cmp.Diff(wantFiles, gotFiles))
+	}
+}
+
+func TestReportHotspots(t *testing.T) {
+	data := report.Results{
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("one.go", 3, 10)},
+			stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("one.go", 3, 11)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("three.go", 3, 10)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("three.go", 3, 11)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("three.go", 3, 12)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("two_a.go", 3, 10)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("two_a.go", 3, 11)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("two_b.go", 3, 10)},
+			stubMutant{status: mutator.NotCovered, mutantType: mutator.ArithmeticBase, position: newPosition("two_b.go", 3, 11)},
+			stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("lived.go", 3, 10)},
+		},
+		Elapsed: 1 * time.Minute,
+	}
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	viper.Set(configuration.UnleashHotspotsKey, 3)
+	defer viper.Reset()
+	out := &bytes.Buffer{}
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	wantLog := "\n" +
+		"Mutation hotspots:\n" +
+		"three.go: 3 NOT COVERED\n" +
+		"two_a.go: 2 NOT COVERED\n" +
+		"two_b.go: 2 NOT COVERED\n"
+	got := out.String()
+	if !strings.Contains(got, wantLog) {
+		t.Errorf("expected the hotspots to be logged, got:\n%s", got)
+	}
+	if strings.Contains(got, "one.go: 1 NOT COVERED") {
+		t.Errorf("expected the hotspots to be limited, got:\n%s", got)
+	}
+	file, _ := os.ReadFile(output)
+	var res internal.OutputResult
+	if err := json.Unmarshal(file, &res); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+	wantHotspots := []internal.OutputHotspot{
+		{Filename: "three.go", NotCovered: 3},
+		{Filename: "two_a.go", NotCovered: 2},
+		{Filename: "two_b.go", NotCovered: 2},
+	}
+	if !cmp.Equal(res.Hotspots, wantHotspots) {
+		t.Errorf(cmp.Diff(wantHotspots, res.Hotspots))
+	}
+}
+
+func TestReportGroupedByType(t *testing.T) {
+	mutants := []mutator.Mutator{
+		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsBoundary, position: newPosition("file2.go", 3, 10)},
+		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 7, 40)},
+		stubMutant{status: mutator.Killed, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 9, 40)},
+		stubMutant{status: mutator.NotCovered, mutantType: mutator.ConditionalsBoundary, position: newPosition("file1.go", 2, 5)},
+		stubMutant{status: mutator.TimedOut, mutantType: mutator.ArithmeticBase, position: newPosition("file2.go", 4, 12)},
+	}
+	data := report.Results{
+		Mutants: mutants,
+		Elapsed: 2 * time.Minute,
+	}
+	configuration.Set[string](configuration.UnleashGroupByKey, report.GroupByType)
+	defer configuration.Reset()
+
+	t.Run("it doesn't log the single mutants", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		logger := report.NewLogger()
+		for _, m := range mutants {
+			logger.Mutant(m)
+		}
+
+		if got := out.String(); got != "" {
+			t.Errorf("expected no output, got:\n%s", got)
+		}
+	})
+
+	t.Run("it logs the mutants grouped by type and file", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		log.Init(out, &bytes.Buffer{})
+		defer log.Reset()
+
+		_ = report.Do(data)
+
+		want := "" +
+			"ARITHMETIC_BASE (4)\n" +
+			"    file1.go: KILLED 2, LIVED 1\n" +
+			"    file2.go: TIMED OUT 1\n" +
+			"CONDITIONALS_BOUNDARY (2)\n" +
+			"    file1.go: NOT COVERED 1\n" +
+			"    file2.go: KILLED 1\n" +
+			"\n" +
+			"Mutation testing completed in 2 minutes\n"
+		got := out.String()
+
+		if !strings.HasPrefix(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+}
+
+func TestRenderJUnit(t *testing.T) {
+	data := report.Results{
+		Module: "example.com/go/module",
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 20, 8)},
+			stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 10, 3)},
+			stubMutant{status: mutator.NotViable, mutantType: mutator.InvertNegatives, position: newPosition("file2.go", 1, 9)},
+		},
+		Elapsed: 2 * time.Minute,
+	}
+	log.Init(&bytes.Buffer{}, &bytes.Buffer{})
+	defer log.Reset()
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+	out := &bytes.Buffer{}
+	if err := report.Render(out, output, "junit"); err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		Name      string    `xml:"name,attr"`
+		Classname string    `xml:"classname,attr"`
+		Failure   *struct{} `xml:"failure"`
+		Skipped   *struct{} `xml:"skipped"`
+	}
+	var got struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name  string     `xml:"name,attr"`
+			Cases []testCase `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("impossible to unmarshal the JUnit report: %s", err)
+	}
+
+	if got.Tests != 3 || got.Failures != 1 {
+		t.Errorf("expected 3 tests and 1 failure, got %d and %d", got.Tests, got.Failures)
+	}
+	var cases []testCase
+	for _, s := range got.Suites {
+		cases = append(cases, s.Cases...)
+	}
+	want := []testCase{
+		{Name: "CONDITIONALS_NEGATION at file1.go:3:10", Classname: "file1.go"},
+		{Name: "ARITHMETIC_BASE at file1.go:8:20", Classname: "file1.go", Failure: &struct{}{}},
+		{Name: "INVERT_NEGATIVES at file2.go:9:1", Classname: "file2.go", Skipped: &struct{}{}},
+	}
+	if !cmp.Equal(cases, want) {
+		t.Error(cmp.Diff(want, cases))
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	err := report.Render(&bytes.Buffer{}, "findings.json", "pdf")
+	if !errors.Is(err, report.ErrUnknownFormat) {
+		t.Errorf("expected %v, got %v", report.ErrUnknownFormat, err)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// LivedFingerprints reads a previous JSON output file and returns the
+// mutator.Fingerprints of the LIVED mutants it contains.
+// If the file names are reported relative to the module root, or prefixed by
+// the import paths, they are made relative to the calling dir again, like the
+// positions of the mutants.
+func LivedFingerprints(path, callingDir string) (mutator.Fingerprints, error) {
+	f, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossible to read the report: %w", err)
+	}
+	var result internal.OutputResult
+	if err := json.Unmarshal(f, &result); err != nil {
+		return nil, fmt.Errorf("impossible to parse the report: %w", err)
+	}
+
+	fps := make(mutator.Fingerprints)
+	for _, file := range result.Files {
+		fName := reportedToCallingDir(file.Filename, result.GoModule, callingDir)
+		for _, m := range file.Mutations {
+			if m.Status != mutator.Lived.String() {
+				continue
+			}
+			pos := token.Position{Filename: fName, Line: m.Line, Column: m.Column}
+			fps.Add(pos, m.Type)
+		}
+	}
+
+	return fps, nil
+}
+
+// SuppressedFingerprints returns the mutator.Fingerprints of the mutants
+// configured to be suppressed, in the 'file:line:column:TYPE' format.
+// Like in LivedFingerprints, the file names relative to the module root or
+// prefixed by the import paths of the module are made relative to the
+// calling dir.
+func SuppressedFingerprints(module, callingDir string) (mutator.Fingerprints, error) {
+	// configuration.Get can't type cast to []string a value from the
+	// config file, so viper is used directly.
+	values := viper.GetStringSlice(configuration.UnleashSuppressKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	fps := make(mutator.Fingerprints)
+	for _, v := range values {
+		pos, typeName, err := mutator.ParseFingerprint(v)
+		if err != nil {
+			return nil, fmt.Errorf("impossible to suppress %q: %w", v, err)
+		}
+		pos.Filename = reportedToCallingDir(pos.Filename, module, callingDir)
+		fps.Add(pos, typeName)
+	}
+
+	return fps, nil
+}
+
+// reportedToCallingDir makes a file name as reported by position relative to
+// the calling dir again.
+func reportedToCallingDir(fName, module, callingDir string) string {
+	switch {
+	case configuration.Get[bool](configuration.UnleashImportPathsKey) && module != "":
+		return relToCallingDir(strings.TrimPrefix(fName, module+"/"), callingDir)
+	case configuration.Get[bool](configuration.UnleashModuleRootPathsKey):
+		return relToCallingDir(fName, callingDir)
+	}
+
+	return fName
+}
+
+func relToCallingDir(fName, callingDir string) string {
+	rel, _ := filepath.Rel(callingDir, fName)
+
+	return filepath.ToSlash(rel)
+}
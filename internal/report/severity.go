@@ -0,0 +1,79 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"strings"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/execution"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// The severities that can be configured for a mutator.Type. LIVED mutants of
+// high severity fail the run, while the ones of medium and low severity are
+// only warned about.
+const (
+	SeverityHigh   = "high"
+	SeverityMedium = "medium"
+	SeverityLow    = "low"
+)
+
+// severity returns the configured severity of the mutator.Type, or an empty
+// string if it is not configured or not valid.
+func severity(mt mutator.Type) string {
+	s := strings.ToLower(configuration.Get[string](configuration.MutantTypeSeverityKey(mt)))
+	switch s {
+	case SeverityHigh, SeverityMedium, SeverityLow:
+		return s
+	}
+
+	return ""
+}
+
+func reportSeverity(m mutator.Mutator, rep *reportStatus) {
+	if m.Status() != mutator.Lived {
+		return
+	}
+	s := severity(m.Type())
+	if s == "" {
+		return
+	}
+	if rep.livedBySeverity == nil {
+		rep.livedBySeverity = make(map[string]int)
+	}
+	rep.livedBySeverity[s]++
+}
+
+func (r *reportStatus) severityReport() {
+	for _, s := range []string{SeverityHigh, SeverityMedium, SeverityLow} {
+		if n := r.livedBySeverity[s]; n > 0 {
+			log.Warnf("%d LIVED mutants of %s severity\n", n, s)
+		}
+	}
+}
+
+// assessSeverity fails the run when there are LIVED mutants of high
+// severity.
+func (r *reportStatus) assessSeverity() error {
+	if r.livedBySeverity[SeverityHigh] > 0 {
+		return execution.NewExitErr(execution.HighSeverityLived)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"database/sql"
+	"time"
+
+	// Registers the pure Go "sqlite" driver of database/sql.
+	_ "modernc.org/sqlite"
+)
+
+// OutputFormatSQLite is the output-format that writes the results into an
+// SQLite database, for the historical tracking of the runs.
+const OutputFormatSQLite = "sqlite"
+
+// sqliteDriver is the database/sql driver writing the database, a pure Go
+// one, so that it needs neither cgo nor the sqlite3 command line shell.
+const sqliteDriver = "sqlite"
+
+// sqliteSchema creates the tables of the database, if missing. Each run
+// adds a row to runs, and a row to mutants for each of its mutants. The
+// mutations_coverage of a run is NULL when its coverage wasn't gathered.
+const sqliteSchema = `CREATE TABLE IF NOT EXISTS runs (
+  id INTEGER PRIMARY KEY,
+  started_at TEXT NOT NULL,
+  go_module TEXT NOT NULL,
+  test_efficacy REAL NOT NULL,
+  mutations_coverage REAL,
+  mutants_total INTEGER NOT NULL,
+  mutants_killed INTEGER NOT NULL,
+  mutants_lived INTEGER NOT NULL,
+  mutants_not_viable INTEGER NOT NULL,
+  mutants_not_covered INTEGER NOT NULL,
+  elapsed_time REAL NOT NULL
+);
+CREATE TABLE IF NOT EXISTS mutants (
+  run_id INTEGER NOT NULL REFERENCES runs(id),
+  file TEXT NOT NULL,
+  line INTEGER NOT NULL,
+  col INTEGER NOT NULL,
+  type TEXT NOT NULL,
+  status TEXT NOT NULL,
+  function TEXT,
+  duration_ms INTEGER
+);
+`
+
+const (
+	sqliteInsertRun = `INSERT INTO runs (started_at, go_module, test_efficacy, mutations_coverage, mutants_total, mutants_killed, mutants_lived, mutants_not_viable, mutants_not_covered, elapsed_time)
+  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	sqliteInsertMutant = `INSERT INTO mutants (run_id, file, line, col, type, status, function, duration_ms)
+  VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+)
+
+// sqliteReport appends the results of the run to the SQLite database at
+// output, creating it if missing. The run is written in a single
+// transaction.
+func (r *reportStatus) sqliteReport(output string, startedAt time.Time) error {
+	db, err := sql.Open(sqliteDriver, output)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := r.sqliteInsert(tx, startedAt); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *reportStatus) sqliteInsert(tx *sql.Tx, startedAt time.Time) error {
+	res, err := tx.Exec(sqliteInsertRun,
+		startedAt.UTC().Format(time.RFC3339), r.module, r.tEfficacy, r.mutationsCoverage(),
+		r.lived+r.killed+r.notViable, r.killed, r.lived, r.notViable, r.notCovered, r.elapsed.Duration().Seconds())
+	if err != nil {
+		return err
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(sqliteInsertMutant)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stmt.Close() }()
+	for _, fName := range r.sortedFiles() {
+		for _, m := range r.files[fName] {
+			if _, err := stmt.Exec(runID, fName, m.Line, m.Column, m.Type, m.Status, m.Function, m.DurationMs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mutationsCoverage returns the mutation coverage of the runs table, or nil
+// if the coverage has not been gathered.
+func (r *reportStatus) mutationsCoverage() *float64 {
+	if r.covNotGathered {
+		return nil
+	}
+	c := r.mCovered
+
+	return &c
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"sort"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/log"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// SortBySuspicion is the sort-by mode that orders the files by their
+// suspicion, which is the number of their LIVED and NOT COVERED mutants.
+const SortBySuspicion = "suspicion"
+
+// suspiciousFilesNr is the number of most suspicious files shown in the
+// report.
+const suspiciousFilesNr = 5
+
+// fileSuspicion holds the mutants of a file that tell where the test suite
+// is weaker.
+type fileSuspicion struct {
+	fileName   string
+	lived      int
+	notCovered int
+}
+
+func (s fileSuspicion) score() int {
+	return s.lived + s.notCovered
+}
+
+func isSortedBySuspicion() bool {
+	return configuration.Get[string](configuration.UnleashSortByKey) == SortBySuspicion
+}
+
+// suspicionRanking ranks the files by their suspicion score, then by their
+// LIVED mutants and by their name.
+func suspicionRanking(files map[string][]internal.Mutation) []fileSuspicion {
+	ranking := make([]fileSuspicion, 0, len(files))
+	for fName, mutations := range files {
+		s := fileSuspicion{fileName: fName}
+		for _, m := range mutations {
+			switch m.Status {
+			case mutator.Lived.String():
+				s.lived++
+			case mutator.NotCovered.String():
+				s.notCovered++
+			}
+		}
+		ranking = append(ranking, s)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].score() != ranking[j].score() {
+			return ranking[i].score() > ranking[j].score()
+		}
+		if ranking[i].lived != ranking[j].lived {
+			return ranking[i].lived > ranking[j].lived
+		}
+
+		return ranking[i].fileName < ranking[j].fileName
+	})
+
+	return ranking
+}
+
+func (r *reportStatus) suspicionReport() {
+	if len(r.suspicion) == 0 || r.suspicion[0].score() == 0 {
+		return
+	}
+	log.Infoln("")
+	log.Infof("Most suspicious files:\n")
+	for i, s := range r.suspicion {
+		if i == suspiciousFilesNr || s.score() == 0 {
+			break
+		}
+		log.Infof("%s: %d LIVED, %d NOT COVERED\n", s.fileName, s.lived, s.notCovered)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/go-gremlins/gremlins/internal/configuration"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+	"github.com/go-gremlins/gremlins/internal/report/internal"
+)
+
+// tapVersion is the version of the Test Anything Protocol of the report.
+const tapVersion = "TAP version 13"
+
+func isTAPStdout() bool {
+	return configuration.Get[bool](configuration.UnleashTAPStdoutKey)
+}
+
+// tapReport writes the mutants on w in the Test Anything Protocol, one test
+// point per mutant, sorted by file and position:
+//
+//	TAP version 13
+//	1..2
+//	ok 1 - CONDITIONALS_NEGATION at file1.go:3:10
+//	not ok 2 - ARITHMETIC_BASE at file1.go:8:20 # LIVED
+//
+// The LIVED, NOT COVERED and NO TESTS mutants are not ok, and the ones that
+// haven't been tested are skipped.
+func (r *reportStatus) tapReport(w io.Writer) error {
+	count := 0
+	for _, mutations := range r.files {
+		count += len(mutations)
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n1..%d\n", tapVersion, count); err != nil {
+		return err
+	}
+	n := 0
+	for _, fName := range r.sortedFiles() {
+		for _, m := range sortedMutations(r.files[fName]) {
+			n++
+			if _, err := fmt.Fprintf(w, "%s %d - %s at %s:%d:%d%s\n", tapResult(m.Status), n, m.Type, fName, m.Line, m.Column, tapDirective(m.Status)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedFiles returns the names of the files of the report in order.
+func (r *reportStatus) sortedFiles() []string {
+	fNames := make([]string, 0, len(r.files))
+	for fName := range r.files {
+		fNames = append(fNames, fName)
+	}
+	sort.Strings(fNames)
+
+	return fNames
+}
+
+// sortedMutations returns a copy of the mutations of a file sorted by
+// position.
+func sortedMutations(mutations []internal.Mutation) []internal.Mutation {
+	sorted := append([]internal.Mutation(nil), mutations...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Line != sorted[j].Line {
+			return sorted[i].Line < sorted[j].Line
+		}
+
+		return sorted[i].Column < sorted[j].Column
+	})
+
+	return sorted
+}
+
+func tapResult(status string) string {
+	if isFailing(status) {
+		return "not ok"
+	}
+
+	return "ok"
+}
+
+// tapDirective returns the comment of the test point: the status of the
+// mutants not ok, and the SKIP directive of the ones that haven't been tested.
+func tapDirective(status string) string {
+	switch {
+	case isFailing(status):
+		return " # " + status
+	case isUntested(status):
+		return " # SKIP " + status
+	}
+
+	return ""
+}
+
+// isFailing tells if the status of a mutant is a failure of the test suite:
+// a LIVED, NOT COVERED or NO TESTS one.
+func isFailing(status string) bool {
+	switch status {
+	case mutator.Lived.String(), mutator.NotCovered.String(), mutator.NoTests.String():
+		return true
+	}
+
+	return false
+}
+
+// isUntested tells if the status of a mutant is one of the mutants that
+// haven't been tested.
+func isUntested(status string) bool {
+	switch status {
+	case mutator.NotViable.String(), mutator.Skipped.String(), mutator.Runnable.String():
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+// Package gremlins provides the hooks to extend Gremlins with custom mutators
+// without forking it.
+//
+// Custom mutators must be registered before the Gremlins command is
+// executed, for example in a custom main:
+//
+//	func main() {
+//		mt := gremlins.NewMutantType("REMOVE_ANSWER")
+//		err := gremlins.RegisterMutator(gremlins.MutatorSpec{
+//			Type: mt,
+//			Matches: func(n ast.Node) bool {
+//				l, ok := n.(*ast.BasicLit)
+//				return ok && l.Value == "42"
+//			},
+//			Pos: func(n ast.Node) token.Pos {
+//				return n.Pos()
+//			},
+//			Mutate: func(n ast.Node) func() {
+//				l := n.(*ast.BasicLit)
+//				l.Value = "0"
+//				return func() { l.Value = "42" }
+//			},
+//		})
+//		if err != nil {
+//			panic(err)
+//		}
+//		_ = cmd.Execute(context.Background(), "custom")
+//	}
+//
+// Once registered, the custom mutant type can be enabled and disabled like
+// the built-in ones, with a flag or in the configuration file.
+package gremlins
+
+import (
+	"github.com/go-gremlins/gremlins/internal/engine"
+	"github.com/go-gremlins/gremlins/internal/mutator"
+)
+
+// MutantType is the category of a mutant.
+type MutantType = mutator.Type
+
+// MutatorSpec declares a mutation that can be discovered and applied by
+// Gremlins.
+type MutatorSpec = engine.MutatorSpec
+
+// NewMutantType registers a new custom MutantType with the given name.
+// The name is used in the reports and to derive the flag and the
+// configuration key to enable the mutant type.
+func NewMutantType(name string) MutantType {
+	return mutator.NewType(name)
+}
+
+// RegisterMutator registers a custom MutatorSpec.
+func RegisterMutator(spec MutatorSpec) error {
+	return engine.RegisterMutatorSpec(spec)
+}